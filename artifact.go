@@ -0,0 +1,130 @@
+package version
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// artifactVersion is the version split as maven's DefaultArtifactVersion does,
+// <major>.<minor>.<incremental>-<build number or qualifier>.
+type artifactVersion struct {
+	major       int
+	minor       int
+	incremental int
+	buildNumber int
+	qualifier   string
+}
+
+// artifactVersion splits the version string as DefaultArtifactVersion.parseVersion of maven 3.9 does.
+// The part after the first "-" is the build number if it is a number without leading zeros, otherwise the qualifier.
+// The part before it is up to 3 numbers separated by ".", followed by the qualifier after another ".".
+// A version which does not fit, e.g. "1.2rc1", "1.02" or "1.2.3.4", falls back to
+// all numbers being 0 and the qualifier being the whole version.
+// Digits are ASCII only, as in NewVersion, and the build metadata of WithBuildMetadata is not a part of the version.
+func (v1 Version) artifactVersion() artifactVersion {
+	v := v1.withoutBuildMetadata()
+	whole := artifactVersion{qualifier: v}
+
+	var av artifactVersion
+	part1, part2, found := strings.Cut(v, "-")
+	if found {
+		if len(part2) == 1 || !strings.HasPrefix(part2, "0") {
+			if n, ok := parseArtifactInt(part2); ok {
+				av.buildNumber = n
+			} else {
+				av.qualifier = part2
+			}
+		} else {
+			av.qualifier = part2
+		}
+	}
+
+	if !strings.Contains(part1, ".") && !strings.HasPrefix(part1, "0") {
+		n, ok := parseArtifactInt(part1)
+		if !ok {
+			return whole
+		}
+		av.major = n
+		return av
+	}
+
+	tokens := strings.FieldsFunc(part1, func(r rune) bool { return r == '.' })
+	fallback := len(tokens) == 0
+	numbers := []*int{&av.major, &av.minor, &av.incremental}
+	for i, token := range tokens {
+		if i == len(numbers) {
+			// as maven does, the 4th token alone decides the fallback, and the tokens after it are ignored
+			av.qualifier = token
+			fallback = isDigits(token)
+			break
+		}
+		n, ok := 0, false
+		if len(token) == 1 || !strings.HasPrefix(token, "0") {
+			n, ok = parseArtifactInt(token)
+		}
+		if !ok {
+			fallback = true
+		}
+		*numbers[i] = n
+	}
+	// the empty tokens are skipped by StringTokenizer
+	if strings.Contains(part1, "..") || strings.HasPrefix(part1, ".") || strings.HasSuffix(part1, ".") {
+		fallback = true
+	}
+	if fallback {
+		return whole
+	}
+	return av
+}
+
+// parseArtifactInt parses s as a number which fits in a java int.
+func parseArtifactInt(s string) (int, bool) {
+	if !isDigits(s) {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err != nil || n > math.MaxInt32 {
+		return 0, false
+	}
+	return int(n), true
+}
+
+func isDigits(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if !isDigitByte(s[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// Major returns the major version, e.g. 1 for "1.2.3-SNAPSHOT".
+func (v1 Version) Major() int {
+	return v1.artifactVersion().major
+}
+
+// Minor returns the minor version, e.g. 2 for "1.2.3-SNAPSHOT".
+func (v1 Version) Minor() int {
+	return v1.artifactVersion().minor
+}
+
+// Incremental returns the incremental version, e.g. 3 for "1.2.3-SNAPSHOT".
+func (v1 Version) Incremental() int {
+	return v1.artifactVersion().incremental
+}
+
+// BuildNumber returns the build number, e.g. 4 for "1.2.3-4".
+func (v1 Version) BuildNumber() int {
+	return v1.artifactVersion().buildNumber
+}
+
+// Qualifier returns the qualifier as it is written in the version, e.g. "SNAPSHOT" for "1.2.3-SNAPSHOT",
+// "rc1" for "1.2-rc1" and "RELEASE" for "1.2.3.RELEASE".
+// A version that does not fit <major>.<minor>.<incremental>-<qualifier> returns the whole version.
+func (v1 Version) Qualifier() string {
+	return v1.artifactVersion().qualifier
+}
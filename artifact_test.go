@@ -0,0 +1,68 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+func TestVersion_ArtifactVersion(t *testing.T) {
+	tests := []struct {
+		v           string
+		major       int
+		minor       int
+		incremental int
+		buildNumber int
+		qualifier   string
+	}{
+		{"1", 1, 0, 0, 0, ""},
+		{"1.2", 1, 2, 0, 0, ""},
+		{"1.2.3", 1, 2, 3, 0, ""},
+		{"1.2.3-4", 1, 2, 3, 4, ""},
+		{"1.2.3-SNAPSHOT", 1, 2, 3, 0, "SNAPSHOT"},
+		{"1.2.3-4-SNAPSHOT", 1, 2, 3, 0, "4-SNAPSHOT"},
+		{"1.2.3.RELEASE", 1, 2, 3, 0, "RELEASE"},
+		{"1.0.0-alpha-1", 1, 0, 0, 0, "alpha-1"},
+		{"1.2-rc1", 1, 2, 0, 0, "rc1"},
+		{"2.5.6.SEC01", 2, 5, 6, 0, "SEC01"},
+		{"0.0.1", 0, 0, 1, 0, ""},
+		{"0", 0, 0, 0, 0, ""},
+		{"1.2.3-01", 1, 2, 3, 0, "01"},
+		{"1.x.3.a", 1, 0, 3, 0, "a"}, // the 4th token resets the fallback in maven
+
+		// fallback
+		{"1.2.3.4", 0, 0, 0, 0, "1.2.3.4"},
+		{"RC1", 0, 0, 0, 0, "RC1"},
+		{"-1", 0, 0, 0, 0, "-1"},
+		{"99999999999999999999.1", 0, 0, 0, 0, "99999999999999999999.1"},
+		{"1.2rc1", 0, 0, 0, 0, "1.2rc1"},
+		{"1.02.003", 0, 0, 0, 0, "1.02.003"},
+		{"1.0.0.0-cr", 0, 0, 0, 0, "1.0.0.0-cr"},
+		{"1..2", 0, 0, 0, 0, "1..2"},
+		{"1.2.", 0, 0, 0, 0, "1.2."},
+		{"2147483648", 0, 0, 0, 0, "2147483648"},
+		{"1.99999999999999999999", 0, 0, 0, 0, "1.99999999999999999999"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			v, err := version.NewVersion(tt.v)
+			require.NoError(t, err)
+			assert.Equal(t, tt.major, v.Major(), "major")
+			assert.Equal(t, tt.minor, v.Minor(), "minor")
+			assert.Equal(t, tt.incremental, v.Incremental(), "incremental")
+			assert.Equal(t, tt.buildNumber, v.BuildNumber(), "build number")
+			assert.Equal(t, tt.qualifier, v.Qualifier(), "qualifier")
+		})
+	}
+}
+
+func TestVersion_ArtifactVersionModeMaven(t *testing.T) {
+	v, err := version.NewVersion("1.0.0-alpha1", version.WithMode(version.ModeMaven))
+	require.NoError(t, err)
+	assert.Equal(t, 1, v.Major())
+	assert.Equal(t, 0, v.Minor())
+	assert.Equal(t, "alpha1", v.Qualifier())
+}
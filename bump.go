@@ -0,0 +1,123 @@
+package version
+
+import (
+	"strings"
+
+	"golang.org/x/xerrors"
+)
+
+// NextMajor returns the next major version, e.g. "2.0.0-SNAPSHOT" for "1.2.3-SNAPSHOT".
+// The separators, the zero padding of each number and the qualifier are kept.
+// A timestamped snapshot is bumped from its BaseVersion, e.g. "2.0.0-SNAPSHOT" for "1.2.0-20231010.123456-3",
+// since the timestamp belongs to a deployed build of the old version.
+func (v1 Version) NextMajor() (Version, error) {
+	return v1.next(0)
+}
+
+// NextMinor returns the next minor version, e.g. "1.3.0-SNAPSHOT" for "1.2.3-SNAPSHOT".
+func (v1 Version) NextMinor() (Version, error) {
+	return v1.next(1)
+}
+
+// NextIncremental returns the next incremental version, e.g. "1.2.4-SNAPSHOT" for "1.2.3-SNAPSHOT".
+func (v1 Version) NextIncremental() (Version, error) {
+	return v1.next(2)
+}
+
+// WithQualifier returns the version with its qualifier replaced by q, e.g. "1.2.3-rc1" for "1.2.3-SNAPSHOT".
+// The separator of the existing qualifier is kept, otherwise "-" is used.
+func (v1 Version) WithQualifier(q string) (Version, error) {
+	if q == "" {
+		return v1.WithoutQualifier()
+	}
+	numbers, rest, err := v1.splitNumbers()
+	if err != nil {
+		return Version{}, err
+	}
+
+	sep := "-"
+	if strings.HasPrefix(rest, ".") {
+		sep = "."
+	}
+	return newVersion(numbers+sep+q, v1.opts)
+}
+
+// WithoutQualifier returns the version without its qualifier, e.g. "1.2.3" for "1.2.3-SNAPSHOT".
+func (v1 Version) WithoutQualifier() (Version, error) {
+	numbers, _, err := v1.splitNumbers()
+	if err != nil {
+		return Version{}, err
+	}
+	return newVersion(numbers, v1.opts)
+}
+
+// ToRelease returns the release of a snapshot version, e.g. "1.2.3" for "1.2.3-SNAPSHOT" or "1.2.3-20231010.123456-3".
+func (v1 Version) ToRelease() (Version, error) {
+	base := v1.BaseVersion().withoutBuildMetadata()
+	suffix := "-" + snapshotQualifier
+	if !hasSuffixFold(base, suffix) {
+		return Version{}, xerrors.Errorf("not a snapshot version: %s", v1.Value)
+	}
+	return newVersion(base[:len(base)-len(suffix)], v1.opts)
+}
+
+// ToSnapshot returns the snapshot of a version, e.g. "1.2.3-SNAPSHOT" for "1.2.3".
+// The next development version is v.NextIncremental() followed by ToSnapshot().
+func (v1 Version) ToSnapshot() (Version, error) {
+	value := v1.withoutBuildMetadata()
+	if v1.IsTimestampedSnapshot() || hasSuffixFold(value, "-"+snapshotQualifier) {
+		return Version{}, xerrors.Errorf("already a snapshot version: %s", v1.Value)
+	}
+	return newVersion(value+"-"+snapshotQualifier, v1.opts)
+}
+
+func (v1 Version) next(index int) (Version, error) {
+	numbers, rest, err := v1.BaseVersion().splitNumbers()
+	if err != nil {
+		return Version{}, err
+	}
+
+	segments := strings.Split(numbers, ".")
+	for len(segments) <= index {
+		segments = append(segments, "0")
+	}
+	segments[index] = incrementDigits(segments[index])
+	for i := index + 1; i < len(segments); i++ {
+		segments[i] = strings.Repeat("0", len(segments[i]))
+	}
+	return newVersion(strings.Join(segments, ".")+rest, v1.opts)
+}
+
+// splitNumbers splits the leading dot separated numbers from the rest of the version,
+// e.g. "1.2.3" and "-SNAPSHOT" for "1.2.3-SNAPSHOT".
+func (v1 Version) splitNumbers() (numbers, rest string, err error) {
+	v := v1.withoutBuildMetadata()
+	i := 0
+	for i < len(v) && isDigitByte(v[i]) {
+		i++
+		if i+1 < len(v) && v[i] == '.' && isDigitByte(v[i+1]) {
+			i++
+		}
+	}
+	if i == 0 {
+		return "", "", xerrors.Errorf("no numeric version: %s", v)
+	}
+	return v[:i], v[i:], nil
+}
+
+// incrementDigits adds 1 to a decimal number keeping its width, e.g. "09" => "10".
+func incrementDigits(s string) string {
+	b := []byte(s)
+	for i := len(b) - 1; i >= 0; i-- {
+		if b[i] < '9' {
+			b[i]++
+			return string(b)
+		}
+		b[i] = '0'
+	}
+	return "1" + string(b)
+}
+
+func hasSuffixFold(s, suffix string) bool {
+	return len(s) >= len(suffix) && toLowerASCII(s[len(s)-len(suffix):]) == toLowerASCII(suffix)
+}
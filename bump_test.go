@@ -0,0 +1,155 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+func TestVersion_Next(t *testing.T) {
+	tests := []struct {
+		v           string
+		major       string
+		minor       string
+		incremental string
+	}{
+		{"1.2.3", "2.0.0", "1.3.0", "1.2.4"},
+		{"1.2.3-SNAPSHOT", "2.0.0-SNAPSHOT", "1.3.0-SNAPSHOT", "1.2.4-SNAPSHOT"},
+		{"1.2.3.RELEASE", "2.0.0.RELEASE", "1.3.0.RELEASE", "1.2.4.RELEASE"},
+		{"1.2", "2.0", "1.3", "1.2.1"},
+		{"1", "2", "1.1", "1.0.1"},
+		{"1.09.009", "2.00.000", "1.10.000", "1.09.010"},
+		{"1.99.9", "2.00.0", "1.100.0", "1.99.10"},
+		{"1.2.3.4", "2.0.0.0", "1.3.0.0", "1.2.4.0"},
+		{"1.0RC1", "2.0RC1", "1.1RC1", "1.0.1RC1"},
+		{"1.2.0-20231010.123456-3", "2.0.0-SNAPSHOT", "1.3.0-SNAPSHOT", "1.2.1-SNAPSHOT"},
+		{"99999999999999999999", "100000000000000000000", "99999999999999999999.1", "99999999999999999999.0.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			v, err := version.NewVersion(tt.v)
+			require.NoError(t, err)
+
+			major, err := v.NextMajor()
+			require.NoError(t, err)
+			assert.Equal(t, tt.major, major.String())
+			assert.True(t, major.GreaterThan(v))
+
+			minor, err := v.NextMinor()
+			require.NoError(t, err)
+			assert.Equal(t, tt.minor, minor.String())
+			assert.True(t, minor.GreaterThan(v))
+
+			incremental, err := v.NextIncremental()
+			require.NoError(t, err)
+			assert.Equal(t, tt.incremental, incremental.String())
+			assert.True(t, incremental.GreaterThan(v))
+		})
+	}
+
+	v, err := version.NewVersion("RELEASE")
+	require.NoError(t, err)
+	_, err = v.NextMajor()
+	assert.Error(t, err)
+}
+
+func TestVersion_Literal(t *testing.T) {
+	// a Version which is not parsed has no options
+	v := version.Version{Value: "1.0-SNAPSHOT"}
+
+	rc, err := v.WithQualifier("rc1")
+	require.NoError(t, err)
+	assert.Equal(t, "1.0-rc1", rc.String())
+	assert.True(t, rc.Equal(mustVersion(t, "1.0-rc1")))
+
+	next, err := v.NextMinor()
+	require.NoError(t, err)
+	assert.Equal(t, "1.1-SNAPSHOT", next.String())
+
+	ts := version.Version{Value: "1.0-20231010.123456-3"}
+	assert.Equal(t, "1.0-SNAPSHOT", ts.BaseVersion().String())
+	release, err := ts.ToRelease()
+	require.NoError(t, err)
+	assert.Equal(t, "1.0", release.String())
+}
+
+func TestVersion_Qualifier(t *testing.T) {
+	tests := []struct {
+		v       string
+		q       string
+		with    string
+		without string
+	}{
+		{"1.2.3", "rc1", "1.2.3-rc1", "1.2.3"},
+		{"1.2.3-SNAPSHOT", "beta-2", "1.2.3-beta-2", "1.2.3"},
+		{"1.2.3.RELEASE", "Final", "1.2.3.Final", "1.2.3"},
+		{"1.2.3-alpha-1-SNAPSHOT", "alpha-2", "1.2.3-alpha-2", "1.2.3"},
+		{"1.0RC1", "RC2", "1.0-RC2", "1.0"},
+		{"1.2.3-SNAPSHOT", "", "1.2.3", "1.2.3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			v, err := version.NewVersion(tt.v)
+			require.NoError(t, err)
+
+			with, err := v.WithQualifier(tt.q)
+			require.NoError(t, err)
+			assert.Equal(t, tt.with, with.String())
+
+			without, err := v.WithoutQualifier()
+			require.NoError(t, err)
+			assert.Equal(t, tt.without, without.String())
+		})
+	}
+}
+
+func TestVersion_ReleaseAndSnapshot(t *testing.T) {
+	tests := []struct {
+		v        string
+		release  string
+		snapshot string
+	}{
+		{"1.2.3-SNAPSHOT", "1.2.3", ""},
+		{"1.2.3-snapshot", "1.2.3", ""},
+		{"1.2.3-20231010.123456-3", "1.2.3", ""},
+		{"1.2.3-alpha-1-SNAPSHOT", "1.2.3-alpha-1", ""},
+		{"1.2.3", "", "1.2.3-SNAPSHOT"},
+		{"1.2.3.RELEASE", "", "1.2.3.RELEASE-SNAPSHOT"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			v, err := version.NewVersion(tt.v)
+			require.NoError(t, err)
+
+			release, err := v.ToRelease()
+			if tt.release == "" {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.release, release.String())
+			}
+
+			snapshot, err := v.ToSnapshot()
+			if tt.snapshot == "" {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.snapshot, snapshot.String())
+			}
+		})
+	}
+
+	// maven-release-plugin flow: 1.2.3-SNAPSHOT => 1.2.3 => 1.2.4-SNAPSHOT
+	dev, err := version.NewVersion("1.2.3-SNAPSHOT")
+	require.NoError(t, err)
+	release, err := dev.ToRelease()
+	require.NoError(t, err)
+	next, err := release.NextIncremental()
+	require.NoError(t, err)
+	nextDev, err := next.ToSnapshot()
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.4-SNAPSHOT", nextDev.String())
+}
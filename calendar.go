@@ -0,0 +1,167 @@
+package version
+
+import (
+	"time"
+)
+
+// CalendarKind tells whether a version is based on a date.
+type CalendarKind int
+
+const (
+	// CalendarNone is a version not based on a date, e.g. "3.2.2".
+	CalendarNone CalendarKind = iota
+	// CalendarDate is a date stamp, optionally with the time and a short prefix,
+	// e.g. "20040616", "200406161230" and "r20231010".
+	CalendarDate
+	// CalendarYear is a version starting with a year, e.g. "2023.10.1" and "2020.0.0".
+	CalendarYear
+)
+
+var calendarKindNames = map[CalendarKind]string{
+	CalendarNone: "none",
+	CalendarDate: "date",
+	CalendarYear: "year",
+}
+
+func (k CalendarKind) String() string {
+	return calendarKindNames[k]
+}
+
+// CalendarPolicy decides how versions based on a date are ordered against the other versions.
+type CalendarPolicy int
+
+const (
+	// CalendarMixed orders all versions by the maven order, e.g. "20040616" > "3.2.2".
+	CalendarMixed CalendarPolicy = iota
+	// CalendarDateBelow ranks date stamps below all the other versions, e.g. "20040616" < "1.0" < "2023.10.1".
+	CalendarDateBelow
+	// CalendarBelow ranks date stamps and versions starting with a year below all the other versions,
+	// e.g. "20040616" < "2023.10.1" < "1.0".
+	CalendarBelow
+)
+
+const (
+	calendarMinYear = 1970
+	calendarMaxYear = 2099
+)
+
+var calendarLayouts = map[int]string{
+	8:  "20060102",
+	12: "200601021504",
+	14: "20060102150405",
+}
+
+// Calendar detects whether the version is based on a date by its first number.
+// The number is a date stamp if it is a valid date of 8 digits, or of 12 or 14 digits with the time,
+// and it is a year if it is between 1970 and 2099.
+// The number may follow a prefix of up to 3 letters which is not a known qualifier, e.g. "r" or "v".
+func (v1 Version) Calendar() CalendarKind {
+	if v1.calendar > 0 {
+		return v1.calendar - 1
+	}
+	return detectCalendar(v1.Items)
+}
+
+// withCalendar detects the calendar kind of a version with a CalendarPolicy once, so that Compare does not.
+func (v1 Version) withCalendar() Version {
+	if v1.opts.calendarPolicy != CalendarMixed {
+		v1.calendar = detectCalendar(v1.Items) + 1
+	}
+	return v1
+}
+
+func detectCalendar(items ListItem) CalendarKind {
+	number, ok := calendarNumber(items)
+	if !ok {
+		return CalendarNone
+	}
+
+	if i, ok := number.(IntItem); ok && calendarMinYear <= i && i <= calendarMaxYear {
+		return CalendarYear
+	}
+	digits := Node{item: number}.Number()
+	if layout, ok := calendarLayouts[len(digits)]; ok {
+		t, err := time.Parse(layout, digits)
+		if err == nil && calendarMinYear <= t.Year() && t.Year() <= calendarMaxYear {
+			return CalendarDate
+		}
+	}
+	return CalendarNone
+}
+
+// IsCalendar returns true if the version is based on a date, see Calendar.
+func (v1 Version) IsCalendar() bool {
+	return v1.Calendar() != CalendarNone
+}
+
+// calendarNumber returns the first number of items, after a prefix.
+func calendarNumber(items ListItem) (Item, bool) {
+	if len(items) == 0 {
+		return nil, false
+	}
+	switch v := items[0].(type) {
+	case IntItem, BigIntItem:
+		return v, true
+	case StringItem:
+		// "r20231010" is parsed as [r, [20231010]]
+		if len(items) < 2 || !isCalendarPrefix(v) {
+			return nil, false
+		}
+		if l, ok := items[1].(ListItem); ok && len(l) > 0 {
+			switch n := l[0].(type) {
+			case IntItem, BigIntItem:
+				return n, true
+			}
+		}
+	case CombinationItem:
+		// "r20231010" is parsed as [r20231010] in ModeMaven
+		if isCalendarPrefix(v.stringPart) {
+			return v.digitPart, true
+		}
+	}
+	return nil, false
+}
+
+func isCalendarPrefix(item StringItem) bool {
+	if len(item.value) == 0 || len(item.value) > 3 {
+		return false
+	}
+	if _, known := item.rank(); known {
+		return false
+	}
+	for i := 0; i < len(item.value); i++ {
+		if c := item.value[i]; c < 'a' || 'z' < c {
+			return false
+		}
+	}
+	return true
+}
+
+// belowByCalendar returns true if the version is ranked below the others by the calendar policy.
+func (v1 Version) belowByCalendar(policy CalendarPolicy) bool {
+	switch policy {
+	case CalendarDateBelow:
+		return v1.Calendar() == CalendarDate
+	case CalendarBelow:
+		return v1.IsCalendar()
+	}
+	return false
+}
+
+// compareCalendar compares the versions by the calendar policy, and returns 0 if the policy does not decide.
+// If the versions have different policies, the one ranking more versions below is used for both,
+// so that the result is antisymmetric.
+func compareCalendar(v1, v2 Version) int {
+	policy := max(v1.opts.calendarPolicy, v2.opts.calendarPolicy)
+	if policy == CalendarMixed {
+		return 0
+	}
+	b1, b2 := v1.belowByCalendar(policy), v2.belowByCalendar(policy)
+	switch {
+	case b1 && !b2:
+		return -1
+	case !b1 && b2:
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,154 @@
+package version_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+func TestVersion_Calendar(t *testing.T) {
+	tests := []struct {
+		v    string
+		want version.CalendarKind
+	}{
+		{"20040616", version.CalendarDate},
+		{"20040616.123456", version.CalendarDate},
+		{"200406161230", version.CalendarDate},
+		{"20040616123045", version.CalendarDate},
+		{"r20231010", version.CalendarDate},
+		{"v20231010-SNAPSHOT", version.CalendarDate},
+		{"2023.10.1", version.CalendarYear},
+		{"2020.0.0", version.CalendarYear},
+		{"v2023.10.1", version.CalendarYear},
+		{"3.2.2", version.CalendarNone},
+		{"1.0-20231010", version.CalendarNone},
+		{"20041316", version.CalendarNone},  // no 13th month
+		{"19000616", version.CalendarNone},  // too old
+		{"123456789", version.CalendarNone}, // 9 digits
+		{"2100.1", version.CalendarNone},    // too late for a year
+		{"alpha20231010", version.CalendarNone},
+		{"b20231010", version.CalendarNone}, // shorthand of beta
+		{"Hoxton.SR3", version.CalendarNone},
+		{"", version.CalendarNone},
+	}
+	for _, tt := range tests {
+		for _, mode := range []version.Mode{version.ModeSpec, version.ModeMaven} {
+			v, err := version.NewVersion(tt.v, version.WithMode(mode))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, v.Calendar(), "mode %d: %s", mode, tt.v)
+			assert.Equal(t, tt.want != version.CalendarNone, v.IsCalendar(), "mode %d: %s", mode, tt.v)
+		}
+	}
+}
+
+func TestWithCalendarPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy version.CalendarPolicy
+		// sorted
+		versions []string
+	}{
+		{
+			name:     "mixed",
+			policy:   version.CalendarMixed,
+			versions: []string{"1.0", "3.2.2", "2023.10.1", "20040616"},
+		},
+		{
+			name:     "date below",
+			policy:   version.CalendarDateBelow,
+			versions: []string{"20031231", "20040616", "1.0", "3.2.2", "2023.10.1"},
+		},
+		{
+			name:     "below",
+			policy:   version.CalendarBelow,
+			versions: []string{"r20231010", "2023.10.1", "20040616", "1.0", "3.2.2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var vs []version.Version
+			for _, s := range tt.versions {
+				v, err := version.NewVersion(s, version.WithCalendarPolicy(tt.policy))
+				require.NoError(t, err)
+				vs = append(vs, v)
+			}
+			for i := range vs {
+				for j := range vs {
+					want := 0
+					if i < j {
+						want = -1
+					} else if i > j {
+						want = 1
+					}
+					assert.Equal(t, want, vs[i].Compare(vs[j]), "%s %s", vs[i], vs[j])
+					assert.Equal(t, want, bytes.Compare(vs[i].SortKey(), vs[j].SortKey()), "%s %s", vs[i], vs[j])
+
+					got, _ := version.ExplainCompare(vs[i], vs[j])
+					assert.Equal(t, want, got, "%s %s", vs[i], vs[j])
+				}
+
+				d, err := version.DecodeSortKey(vs[i].SortKey(), version.WithCalendarPolicy(tt.policy))
+				require.NoError(t, err)
+				assert.True(t, d.Equal(vs[i]))
+			}
+		})
+	}
+}
+
+func TestWithCalendarPolicy_Constraints(t *testing.T) {
+	c, err := version.NewConstraints(">= 3.0", version.WithCalendarPolicy(version.CalendarDateBelow))
+	require.NoError(t, err)
+
+	v, err := version.NewVersion("20040616", version.WithCalendarPolicy(version.CalendarDateBelow))
+	require.NoError(t, err)
+	assert.False(t, c.Check(v))
+
+	latest, ok := version.Versions{v, mustVersion(t, "3.2.2", version.WithCalendarPolicy(version.CalendarDateBelow))}.Max()
+	require.True(t, ok)
+	assert.Equal(t, "3.2.2", latest.String())
+}
+
+func TestWithCalendarPolicy_Different(t *testing.T) {
+	policies := []version.CalendarPolicy{version.CalendarMixed, version.CalendarDateBelow, version.CalendarBelow}
+	for _, p1 := range policies {
+		for _, p2 := range policies {
+			for _, pair := range [][2]string{{"20040616", "3.2.2"}, {"2023.10.1", "3.2.2"}, {"20040616", "2023.10.1"}} {
+				v1 := mustVersion(t, pair[0], version.WithCalendarPolicy(p1))
+				v2 := mustVersion(t, pair[1], version.WithCalendarPolicy(p2))
+				assert.Equal(t, v1.Compare(v2), -v2.Compare(v1), "%s (%d) %s (%d)", v1, p1, v2, p2)
+			}
+		}
+	}
+
+	// CalendarBelow ranks more versions below than CalendarDateBelow
+	v1 := mustVersion(t, "2023.10.1", version.WithCalendarPolicy(version.CalendarDateBelow))
+	v2 := mustVersion(t, "3.2.2", version.WithCalendarPolicy(version.CalendarBelow))
+	assert.Equal(t, -1, v1.Compare(v2))
+	assert.Equal(t, 1, v2.Compare(v1))
+}
+
+func TestWithCalendarPolicy_CompareAllocs(t *testing.T) {
+	var vs []version.Version
+	for _, s := range []string{"20040616", "r20231010", "2023.10.1", "3.2.2", "1.0-SNAPSHOT"} {
+		vs = append(vs, mustVersion(t, s, version.WithCalendarPolicy(version.CalendarBelow)))
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, v1 := range vs {
+			for _, v2 := range vs {
+				v1.Compare(v2)
+			}
+		}
+	})
+	assert.Zero(t, allocs)
+}
+
+func mustVersion(t *testing.T, v string, opts ...version.Option) version.Version {
+	t.Helper()
+	ver, err := version.NewVersion(v, opts...)
+	require.NoError(t, err)
+	return ver
+}
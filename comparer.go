@@ -9,16 +9,18 @@ type Comparer interface {
 	Check(v Version) bool
 }
 
-func NewComparer(v string) (Comparer, error) {
+// NewComparer parses v as Constraints, or as Requirements if it is not a constraint.
+// opts are used to parse the versions in v.
+func NewComparer(v string, opts ...Option) (Comparer, error) {
 	var errs error
 
-	c, err := NewConstraints(v)
+	c, err := NewConstraints(v, opts...)
 	if err == nil {
 		return c, nil
 	}
 	errs = multierror.Append(errs, err)
 
-	r, err := NewRequirements(v)
+	r, err := NewRequirements(v, opts...)
 	if err == nil {
 		return r, nil
 	}
@@ -59,7 +59,9 @@ type constraint struct {
 	original string
 }
 
-func NewConstraints(v string) (Constraints, error) {
+// NewConstraints parses constraints such as ">= 1.0, < 2.0 || = 3.0".
+// opts are used to parse the versions in the constraints.
+func NewConstraints(v string, opts ...Option) (Constraints, error) {
 	var css [][]constraint
 	for _, vv := range strings.Split(v, "||") {
 		if !validConstraintRegexp.MatchString(vv) {
@@ -73,7 +75,7 @@ func NewConstraints(v string) (Constraints, error) {
 
 		var cs []constraint
 		for _, single := range ss {
-			c, err := newConstraint(single)
+			c, err := newConstraint(single, opts)
 			if err != nil {
 				return Constraints{}, err
 			}
@@ -86,13 +88,13 @@ func NewConstraints(v string) (Constraints, error) {
 	}, nil
 }
 
-func newConstraint(c string) (constraint, error) {
+func newConstraint(c string, opts []Option) (constraint, error) {
 	m := constraintRegexp.FindStringSubmatch(c)
 	if m == nil {
 		return constraint{}, xerrors.Errorf("improper constraint: %s", c)
 	}
 
-	v, err := NewVersion(m[2])
+	v, err := NewVersion(m[2], opts...)
 	if err != nil {
 		return constraint{}, xerrors.Errorf("version parse error (%s): %w", m[2], err)
 	}
@@ -0,0 +1,110 @@
+package version
+
+// UpdateType classifies the change from one version to another.
+type UpdateType int
+
+const (
+	// UpdateNone is a change to an equal version, e.g. "1.0" to "1".
+	UpdateNone UpdateType = iota
+	UpdateMajor
+	UpdateMinor
+	UpdateIncremental
+	UpdateBuildNumber
+	// UpdateQualifier is a change of the qualifier only, e.g. "1.0-SNAPSHOT" to "1.0".
+	UpdateQualifier
+	// UpdateDowngrade is a change to a lower version.
+	UpdateDowngrade
+)
+
+var updateTypeNames = map[UpdateType]string{
+	UpdateNone:        "none",
+	UpdateMajor:       "major",
+	UpdateMinor:       "minor",
+	UpdateIncremental: "incremental",
+	UpdateBuildNumber: "build number",
+	UpdateQualifier:   "qualifier",
+	UpdateDowngrade:   "downgrade",
+}
+
+func (u UpdateType) String() string {
+	return updateTypeNames[u]
+}
+
+// Diff classifies the update from one version to a higher one by the first segment that differs,
+// in the order of major, minor, incremental, build number and qualifier, as the segments of versions-maven-plugin.
+// e.g. "1.2.3" to "1.3.0" is UpdateMinor, and "1.2.3-1" to "1.2.3-2" is UpdateBuildNumber.
+// The segments are the leading numbers of the items, with the missing ones being 0.
+// The first three are the major, minor and incremental versions, and any further number,
+// or a single number after "-" which follows them, is the build number, as the subincremental segment of the plugin.
+// e.g. "1.2.3" to "1.2.3.1" and "1.2.3.4" to "1.2.3.5" are UpdateBuildNumber.
+// The rest is the qualifier, so a version without leading numbers, e.g. "Hoxton.SR3", only has a qualifier.
+func Diff(from, to Version) UpdateType {
+	switch result := from.Compare(to); {
+	case result == 0:
+		return UpdateNone
+	case result > 0:
+		return UpdateDowngrade
+	}
+
+	f, t := leadingNumbers(from.Items), leadingNumbers(to.Items)
+	segments := []UpdateType{UpdateMajor, UpdateMinor, UpdateIncremental}
+	for i := 0; i < len(f) || i < len(t); i++ {
+		if compareItems(itemAt(f, i), itemAt(t, i)) == 0 {
+			continue
+		}
+		if i < len(segments) {
+			return segments[i]
+		}
+		return UpdateBuildNumber
+	}
+	if compareItems(buildNumber(from.Items[len(f):]), buildNumber(to.Items[len(t):])) != 0 {
+		return UpdateBuildNumber
+	}
+	return UpdateQualifier
+}
+
+// leadingNumbers returns the numbers at the start of items.
+func leadingNumbers(items ListItem) ListItem {
+	n := 0
+	for n < len(items) {
+		switch items[n].(type) {
+		case IntItem, BigIntItem:
+			n++
+			continue
+		}
+		break
+	}
+	return items[:n]
+}
+
+// buildNumber returns the number of rest if it is a single number after "-", e.g. [[4]] of "1.2.3-4", or nil.
+func buildNumber(rest ListItem) Item {
+	if len(rest) != 1 {
+		return nil
+	}
+	if l, ok := rest[0].(ListItem); ok && len(l) == 1 {
+		switch l[0].(type) {
+		case IntItem, BigIntItem:
+			return l[0]
+		}
+	}
+	return nil
+}
+
+func itemAt(items ListItem, i int) Item {
+	if i < len(items) {
+		return items[i]
+	}
+	return nil
+}
+
+// compareItems compares the items, either of which may be nil.
+func compareItems(item1, item2 Item) int {
+	if item1 == nil {
+		if item2 == nil {
+			return 0
+		}
+		return -item2.Compare(nil)
+	}
+	return item1.Compare(item2)
+}
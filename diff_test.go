@@ -0,0 +1,59 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		from string
+		to   string
+		want version.UpdateType
+	}{
+		{"1.0", "1", version.UpdateNone},
+		{"1.0.0.RELEASE", "1", version.UpdateNone},
+		{"1.9.9", "2.0.0", version.UpdateMajor},
+		{"1", "2-SNAPSHOT", version.UpdateMajor},
+		{"1.2.3", "1.3.0", version.UpdateMinor},
+		{"1.2.3", "1.3.0-beta-1", version.UpdateMinor},
+		{"1.2", "1.2.1", version.UpdateIncremental},
+		{"1.2.3", "1.2.4-SNAPSHOT", version.UpdateIncremental},
+		{"1.2.3-1", "1.2.3-2", version.UpdateBuildNumber},
+		{"1.2.3", "1.2.3-1", version.UpdateBuildNumber},
+		{"1.2.3-SNAPSHOT", "1.2.3", version.UpdateQualifier},
+		{"1.2.3-alpha-1", "1.2.3-alpha-2", version.UpdateQualifier},
+		{"1.2.3", "1.2.3-sp", version.UpdateQualifier},
+		{"Hoxton.SR3", "Hoxton.SR4", version.UpdateQualifier},
+		{"1.2.3.4", "1.2.3.5", version.UpdateBuildNumber},
+		{"1.2.3", "1.2.3.1", version.UpdateBuildNumber},
+		{"1.0", "1.0.0.1", version.UpdateBuildNumber},
+		{"1.2.3.4", "1.2.4.0", version.UpdateIncremental},
+		{"1.2.3.4", "1.3", version.UpdateMinor},
+		{"1.2.3.4", "1.2.3.4.1", version.UpdateBuildNumber},
+		{"1.2.3.4", "1.2.3.4-1", version.UpdateBuildNumber},
+		{"1.2.3.4-alpha", "1.2.3.4-beta", version.UpdateQualifier},
+		{"1.2.3", "99999999999999999999.0", version.UpdateMajor},
+		{"2.0.0", "1.9.9", version.UpdateDowngrade},
+		{"1.2.3", "1.2.3-SNAPSHOT", version.UpdateDowngrade},
+	}
+	for _, tt := range tests {
+		t.Run(tt.from+" "+tt.to, func(t *testing.T) {
+			from, err := version.NewVersion(tt.from)
+			require.NoError(t, err)
+			to, err := version.NewVersion(tt.to)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, version.Diff(from, to))
+		})
+	}
+}
+
+func TestUpdateType_String(t *testing.T) {
+	assert.Equal(t, "major", version.UpdateMajor.String())
+	assert.Equal(t, "build number", version.UpdateBuildNumber.String())
+	assert.Equal(t, "downgrade", version.UpdateDowngrade.String())
+}
@@ -0,0 +1,59 @@
+package version
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"golang.org/x/xerrors"
+)
+
+var (
+	// ErrEmptyVersion is returned by strict parsing for an empty version string.
+	ErrEmptyVersion = xerrors.New("empty version")
+	// ErrInvalidCharacter is returned by strict parsing for a character that is not allowed in a version.
+	ErrInvalidCharacter = xerrors.New("invalid character")
+)
+
+// ParseError describes why strict parsing rejected a version string.
+// Use errors.Is with ErrEmptyVersion or ErrInvalidCharacter to check the cause.
+type ParseError struct {
+	Version string
+	// Pos is the byte offset of the offending character in Version.
+	Pos int
+	Err error
+}
+
+func (e *ParseError) Error() string {
+	if e.Pos >= len(e.Version) {
+		return fmt.Sprintf("%s: %q", e.Err, e.Version)
+	}
+	r, _ := utf8.DecodeRuneInString(e.Version[e.Pos:])
+	return fmt.Sprintf("%s %q at position %d: %q", e.Err, r, e.Pos, e.Version)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// validateVersion checks the characters of v for strict parsing.
+func validateVersion(v string) error {
+	if v == "" {
+		return &ParseError{Version: v, Err: ErrEmptyVersion}
+	}
+	for i := 0; i < len(v); i++ {
+		if !isVersionChar(v[i]) {
+			return &ParseError{Version: v, Pos: i, Err: ErrInvalidCharacter}
+		}
+	}
+	return nil
+}
+
+func isVersionChar(c byte) bool {
+	switch {
+	case '0' <= c && c <= '9', 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z':
+		return true
+	case c == '.', c == '-', c == '_', c == '+', c == '~':
+		return true
+	}
+	return false
+}
@@ -0,0 +1,259 @@
+package version
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Explain renders the normalized items of the version with the kind of each item,
+// and the rank of each qualifier, which is used to compare it with other qualifiers.
+// e.g. "1.2-RC1"
+//
+//	ListItem
+//	  IntItem 1
+//	  IntItem 2
+//	  ListItem
+//	    StringItem "rc" rank=3
+//	    ListItem
+//	      IntItem 1
+func (v1 Version) Explain() string {
+	var b strings.Builder
+	explainItem(&b, v1.Items, 0)
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// Format prints the version string, or Explain for "%+v".
+func (v1 Version) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('+') {
+		fmt.Fprint(f, v1.Explain())
+		return
+	}
+	fmt.Fprintf(f, fmt.FormatString(f, verb), v1.Value)
+}
+
+func explainItem(b *strings.Builder, item Item, depth int) {
+	b.WriteString(strings.Repeat("  ", depth))
+	switch v := item.(type) {
+	case IntItem:
+		fmt.Fprintf(b, "IntItem %d\n", v)
+	case BigIntItem:
+		fmt.Fprintf(b, "BigIntItem %s\n", v)
+	case StringItem:
+		fmt.Fprintf(b, "StringItem %q %s\n", v.value, explainRank(v))
+	case CombinationItem:
+		fmt.Fprintf(b, "CombinationItem %q\n", v.String())
+		explainItem(b, v.stringPart, depth+1)
+		explainItem(b, v.digitPart, depth+1)
+	case ListItem:
+		b.WriteString("ListItem\n")
+		for _, i := range v {
+			explainItem(b, i, depth+1)
+		}
+	}
+}
+
+func explainRank(item StringItem) string {
+	rank, known := item.rank()
+	switch {
+	case !known:
+		return fmt.Sprintf("rank=%d (unknown, ordered lexically)", rank)
+	case item.value == "":
+		return fmt.Sprintf("rank=%d (release)", rank)
+	}
+	return fmt.Sprintf("rank=%d", rank)
+}
+
+// Trace describes the items which decided the result of a comparison.
+type Trace struct {
+	Result int
+	// Path is the indexes into the nested ListItems of the items where the versions first differ.
+	Path []int
+	// Left and Right are the items at Path. One of them is nil if the version is padded with null.
+	Left  Item
+	Right Item
+	// Rule is the comparison rule which applied, e.g. "IntItem beats StringItem" or "qualifier rc < release".
+	Rule string
+}
+
+func (t Trace) String() string {
+	if t.Result == 0 {
+		return t.Rule
+	}
+	return fmt.Sprintf("%s at %v", t.Rule, t.Path)
+}
+
+// ExplainCompare compares v1 and v2 as Compare does, and returns the trace of the decision.
+func ExplainCompare(v1, v2 Version) (int, Trace) {
+	if result := compareCalendar(v1, v2); result != 0 {
+		return result, Trace{Result: result, Rule: "the version based on a date is ranked below by the calendar policy"}
+	}
+	t := explainCompare(v1.Items, v2.Items, nil)
+	if t.Result == 0 {
+		t = Trace{Rule: "equal"}
+	}
+	return t.Result, t
+}
+
+func explainCompare(l, r Item, path []int) Trace {
+	switch {
+	case l == nil:
+		return explainNull(r, path, -1)
+	case r == nil:
+		return explainNull(l, path, 1)
+	}
+
+	result := l.Compare(r)
+	t := Trace{
+		Result: result,
+		Path:   append([]int(nil), path...),
+		Left:   l,
+		Right:  r,
+	}
+	switch lv := l.(type) {
+	case IntItem, BigIntItem:
+		switch r.(type) {
+		case IntItem, BigIntItem:
+			t.Rule = fmt.Sprintf("number %s %s %s", l, operator(result), r)
+			return t
+		}
+	case StringItem:
+		switch rv := r.(type) {
+		case StringItem:
+			t.Rule = qualifierRule(lv, rv, result)
+			return t
+		case CombinationItem:
+			if q := lv.Compare(rv.stringPart); q != 0 {
+				t.Rule = qualifierRule(lv, rv.stringPart, q)
+				return t
+			}
+		}
+	case CombinationItem:
+		switch rv := r.(type) {
+		case StringItem:
+			if q := lv.stringPart.Compare(rv); q != 0 {
+				t.Rule = qualifierRule(lv.stringPart, rv, q)
+				return t
+			}
+		case CombinationItem:
+			if q := lv.stringPart.Compare(rv.stringPart); q != 0 {
+				t.Rule = qualifierRule(lv.stringPart, rv.stringPart, q)
+				return t
+			}
+			t.Rule = fmt.Sprintf("number %s %s %s", lv.digitPart, operator(result), rv.digitPart)
+			return t
+		}
+	case ListItem:
+		if rv, ok := r.(ListItem); ok {
+			for i := 0; i < len(lv) || i < len(rv); i++ {
+				var li, ri Item
+				if i < len(lv) {
+					li = lv[i]
+				}
+				if i < len(rv) {
+					ri = rv[i]
+				}
+				if t := explainCompare(li, ri, append(path, i)); t.Result != 0 {
+					return t
+				}
+			}
+			return Trace{}
+		}
+	}
+
+	if result > 0 {
+		t.Rule = fmt.Sprintf("%s beats %s", itemKind(l), itemKind(r))
+	} else {
+		t.Rule = fmt.Sprintf("%s beats %s", itemKind(r), itemKind(l))
+	}
+	if isQualifierItem(l) && isQualifierItem(r) {
+		// only reached when the qualifiers are equal, X1 > X
+		t.Rule += " with the same qualifier"
+	}
+	return t
+}
+
+// explainNull explains the comparison of item with null, sign is -1 if null is on the left.
+func explainNull(item Item, path []int, sign int) Trace {
+	if l, ok := item.(ListItem); ok {
+		// the entire list is compared with null, MNG-6964
+		for i, it := range l {
+			if t := explainNull(it, append(path, i), sign); t.Result != 0 {
+				return t
+			}
+		}
+		return Trace{}
+	}
+
+	result := item.Compare(nil)
+	if result == 0 {
+		return Trace{}
+	}
+
+	t := Trace{
+		Result: sign * result,
+		Path:   append([]int(nil), path...),
+	}
+	var desc string
+	switch v := item.(type) {
+	case StringItem:
+		desc = qualifierRule(v, v.getScheme().qualifierItem(""), result)
+	case CombinationItem:
+		desc = qualifierRule(v.stringPart, v.stringPart.getScheme().qualifierItem(""), result)
+	default:
+		desc = fmt.Sprintf("number %s %s 0", item, operator(result))
+	}
+	if sign > 0 {
+		t.Left = item
+	} else {
+		t.Right = item
+		desc = fmt.Sprintf("%s (null on the left)", desc)
+	}
+	t.Rule = "padding with null: " + desc
+	return t
+}
+
+func qualifierRule(l, r StringItem, result int) string {
+	rule := fmt.Sprintf("qualifier %s %s %s", qualifierName(l), operator(result), qualifierName(r))
+	_, lKnown := l.rank()
+	_, rKnown := r.rank()
+	switch {
+	case !lKnown && !rKnown:
+		rule += " (unknown qualifiers are ordered lexically)"
+	case !lKnown || !rKnown:
+		rule += " (unknown qualifiers are ordered after known ones)"
+	}
+	return rule
+}
+
+func qualifierName(item StringItem) string {
+	if item.value == "" {
+		return "release"
+	}
+	return item.value
+}
+
+func itemKind(item Item) string {
+	switch item.(type) {
+	case IntItem:
+		return "IntItem"
+	case BigIntItem:
+		return "BigIntItem"
+	case StringItem:
+		return "StringItem"
+	case CombinationItem:
+		return "CombinationItem"
+	case ListItem:
+		return "ListItem"
+	}
+	return fmt.Sprintf("%T", item)
+}
+
+func operator(result int) string {
+	switch {
+	case result < 0:
+		return "<"
+	case result > 0:
+		return ">"
+	}
+	return "=="
+}
@@ -0,0 +1,144 @@
+package version_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+func TestVersion_Explain(t *testing.T) {
+	tests := []struct {
+		v    string
+		opts []version.Option
+		want string
+	}{
+		{
+			v: "1.2-RC1",
+			want: `ListItem
+  IntItem 1
+  IntItem 2
+  ListItem
+    StringItem "rc" rank=3
+    ListItem
+      IntItem 1`,
+		},
+		{
+			v: "1.ga.foo-99999999999999999999",
+			want: `ListItem
+  IntItem 1
+  StringItem "" rank=5 (release)
+  StringItem "foo" rank=7 (unknown, ordered lexically)
+  ListItem
+    BigIntItem 99999999999999999999`,
+		},
+		{
+			v:    "1.0-alpha1",
+			opts: []version.Option{version.WithMode(version.ModeMaven)},
+			want: `ListItem
+  IntItem 1
+  ListItem
+    CombinationItem "alpha1"
+      StringItem "alpha" rank=0
+      IntItem 1`,
+		},
+		{
+			v:    "",
+			want: `ListItem`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			v, err := version.NewVersion(tt.v, tt.opts...)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, v.Explain())
+			assert.Equal(t, tt.want, fmt.Sprintf("%+v", v))
+		})
+	}
+}
+
+func TestVersion_Format(t *testing.T) {
+	v, err := version.NewVersion("1.0-SNAPSHOT")
+	require.NoError(t, err)
+
+	assert.Equal(t, "1.0-SNAPSHOT", fmt.Sprintf("%s", v))
+	assert.Equal(t, "1.0-SNAPSHOT", fmt.Sprintf("%v", v))
+	assert.Equal(t, `"1.0-SNAPSHOT"`, fmt.Sprintf("%q", v))
+	assert.Equal(t, "1.0-SNAPSHOT  ", fmt.Sprintf("%-14s", v))
+	assert.Equal(t, "[1.0-SNAPSHOT]", fmt.Sprintf("%v", []version.Version{v}))
+}
+
+func TestExplainCompare(t *testing.T) {
+	tests := []struct {
+		v1, v2    string
+		opts      []version.Option
+		want      int
+		wantPath  []int
+		wantRule  string
+		wantLeft  string
+		wantRight string
+	}{
+		{v1: "1.2", v2: "1.10", want: -1, wantPath: []int{1}, wantRule: "number 2 < 10", wantLeft: "2", wantRight: "10"},
+		{v1: "1.2-rc1", v2: "1.2", want: -1, wantPath: []int{2, 0}, wantRule: "padding with null: qualifier rc < release", wantLeft: "rc"},
+		{v1: "1", v2: "1.1", want: -1, wantPath: []int{1}, wantRule: "padding with null: number 1 > 0 (null on the left)", wantRight: "1"},
+		{v1: "1.1", v2: "1-sp", want: 1, wantPath: []int{1}, wantRule: "IntItem beats ListItem", wantLeft: "1", wantRight: "sp"},
+		{v1: "1.sp", v2: "1.1", want: -1, wantPath: []int{1}, wantRule: "IntItem beats StringItem", wantLeft: "sp", wantRight: "1"},
+		{v1: "1-foo", v2: "1-sp", want: 1, wantPath: []int{1, 0}, wantRule: "qualifier foo > sp (unknown qualifiers are ordered after known ones)", wantLeft: "foo", wantRight: "sp"},
+		{v1: "1-bar", v2: "1-foo", want: -1, wantPath: []int{1, 0}, wantRule: "qualifier bar < foo (unknown qualifiers are ordered lexically)", wantLeft: "bar", wantRight: "foo"},
+		{v1: "99999999999999999999", v2: "1", want: 1, wantPath: []int{0}, wantRule: "number 99999999999999999999 > 1", wantLeft: "99999999999999999999", wantRight: "1"},
+		{
+			v1: "1-alpha1", v2: "1-alpha", opts: []version.Option{version.WithMode(version.ModeMaven)},
+			want: 1, wantPath: []int{1, 0}, wantRule: "CombinationItem beats StringItem with the same qualifier", wantLeft: "alpha1", wantRight: "alpha",
+		},
+		{
+			v1: "1-alpha1", v2: "1-alpha2", opts: []version.Option{version.WithMode(version.ModeMaven)},
+			want: -1, wantPath: []int{1, 0}, wantRule: "number 1 < 2", wantLeft: "alpha1", wantRight: "alpha2",
+		},
+		{v1: "1.0.0.RELEASE", v2: "1-ga", want: 0, wantRule: "equal"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v1+" "+tt.v2, func(t *testing.T) {
+			v1, err := version.NewVersion(tt.v1, tt.opts...)
+			require.NoError(t, err)
+			v2, err := version.NewVersion(tt.v2, tt.opts...)
+			require.NoError(t, err)
+
+			got, trace := version.ExplainCompare(v1, v2)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want, trace.Result)
+			assert.Equal(t, tt.wantPath, trace.Path)
+			assert.Equal(t, tt.wantRule, trace.Rule)
+			assert.Equal(t, tt.wantLeft, itemString(trace.Left))
+			assert.Equal(t, tt.wantRight, itemString(trace.Right))
+		})
+	}
+}
+
+func TestExplainCompare_Corpus(t *testing.T) {
+	for _, mode := range []version.Mode{version.ModeSpec, version.ModeMaven} {
+		var vs []version.Version
+		for _, s := range keyTestVersions {
+			v, err := version.NewVersion(s, version.WithMode(mode))
+			require.NoError(t, err)
+			vs = append(vs, v)
+		}
+
+		for _, v1 := range vs {
+			for _, v2 := range vs {
+				got, trace := version.ExplainCompare(v1, v2)
+				assert.Equal(t, v1.Compare(v2), got, "mode %d: %s %s: %s", mode, v1, v2, trace)
+				assert.NotEmpty(t, trace.Rule)
+			}
+		}
+	}
+}
+
+func itemString(item version.Item) string {
+	if item == nil {
+		return ""
+	}
+	return fmt.Sprint(item)
+}
@@ -0,0 +1,62 @@
+package version
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Key is a comparable representation of a normalized version, which can be used as a map key.
+type Key string
+
+// Key returns the key of the version.
+// Two versions have the same key if and only if Compare returns 0, e.g. "1.0.0.RELEASE", "1-ga" and "1".
+//
+// Compare is not transitive for the qualifiers of ModeMaven that rank as the release followed by a number,
+// e.g. "1-ga1" == "1" == "1-ga2" but "1-ga1" < "1-ga2", and no key can follow it for all of them.
+// In these cases the keys are different, so that versions with the same key are always equal.
+//
+// Keys of versions parsed with different schemes or modes should not be compared.
+func (v1 Version) Key() Key {
+	var b strings.Builder
+	writeKey(&b, trimNull(v1.Items))
+	return Key(b.String())
+}
+
+// trimNull removes the trailing null items (zeros, the release and empty lists) from items and the nested lists,
+// so that two lists are equal if and only if they have the same items.
+// A CombinationItem is kept even if it compares equal to null, e.g. "ga1", since it is not equal to the other ones.
+func trimNull(items ListItem) ListItem {
+	ret := make(ListItem, 0, len(items))
+	for _, item := range items {
+		if l, ok := item.(ListItem); ok {
+			item = trimNull(l)
+		}
+		ret = append(ret, item)
+	}
+	for len(ret) > 0 && ret[len(ret)-1].isNull() {
+		ret = ret[:len(ret)-1]
+	}
+	return ret
+}
+
+func writeKey(b *strings.Builder, item Item) {
+	switch v := item.(type) {
+	case IntItem:
+		b.WriteString("i" + strconv.Itoa(int(v)) + ";")
+	case BigIntItem:
+		b.WriteString("i" + string(v) + ";")
+	case StringItem:
+		// the length is written to make the value unambiguous
+		b.WriteString("s" + strconv.Itoa(len(v.value)) + ":" + v.value)
+	case CombinationItem:
+		b.WriteString("c")
+		writeKey(b, v.stringPart)
+		writeKey(b, v.digitPart)
+	case ListItem:
+		b.WriteString("(")
+		for _, i := range v {
+			writeKey(b, i)
+		}
+		b.WriteString(")")
+	}
+}
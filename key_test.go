@@ -0,0 +1,66 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+var keyTestVersions = []string{
+	"1", "1.0", "1.0.0", "1-0", "1.0-0", "1-ga", "1.0.0.RELEASE", "1.FINAL", "1-1.ga", "1-1-ga", "1-1", "1-1.0",
+	"1--1", "1-0-1", "-1", "0-1", "1..1", "1.0.1", "1.ga.1", "1.1", "1-sp", "1.sp", "1-alpha", "1-a1", "1-alpha-1",
+	"1.0-alpha-1", "1-alpha-1-0", "1-ga1", "1-cr", "1-rc", "1.2.3-SNAPSHOT", "1.2.3-snapshot", "1-foo", "1.foo",
+	"1-foo-0", "1.0.0-foo.0", "2.0.a", "2.0.0.a", "2-a", "99999999999999999999", "099999999999999999999.0",
+	"1-1-ga-0", "1-1-sp", "1.0.alpha", "1-0.alpha", "", "0", "0.0", "a", "A", "1-1-1",
+	"1.0-GA1", "1.0-GA2", "1.0-Final1", "1.0-Final2", "1-1-ga-1",
+}
+
+func TestVersion_Key(t *testing.T) {
+	for _, mode := range []version.Mode{version.ModeSpec, version.ModeMaven} {
+		var vs []version.Version
+		for _, s := range keyTestVersions {
+			v, err := version.NewVersion(s, version.WithMode(mode))
+			require.NoError(t, err)
+			vs = append(vs, v)
+		}
+
+		for _, v1 := range vs {
+			for _, v2 := range vs {
+				equal := v1.Compare(v2) == 0
+				if v1.Key() == v2.Key() {
+					assert.True(t, equal, "mode %d: %s (%s) %s (%s)", mode, v1, v1.Key(), v2, v2.Key())
+				} else if equal {
+					// no key can follow Compare where it is not transitive
+					assert.True(t, inCycle(vs, v1, v2), "mode %d: %s (%s) %s (%s)", mode, v1, v1.Key(), v2, v2.Key())
+				}
+			}
+		}
+	}
+}
+
+func TestVersion_KeyMap(t *testing.T) {
+	groups := map[version.Key][]string{}
+	for _, s := range []string{"1.0.0.RELEASE", "1-ga", "1", "1.0-1", "1-1.0", "2"} {
+		v, err := version.NewVersion(s)
+		require.NoError(t, err)
+		groups[v.Key()] = append(groups[v.Key()], s)
+	}
+	assert.Len(t, groups, 3)
+
+	v, err := version.NewVersion("1.0")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"1.0.0.RELEASE", "1-ga", "1"}, groups[v.Key()])
+}
+
+func TestVersion_KeyCombination(t *testing.T) {
+	opt := version.WithMode(version.ModeMaven)
+	for _, pair := range [][2]string{{"1.0-GA1", "1.0-GA2"}, {"1.0-Final1", "1.0-Final2"}} {
+		v1 := mustVersion(t, pair[0], opt)
+		v2 := mustVersion(t, pair[1], opt)
+		assert.Equal(t, -1, v1.Compare(v2))
+		assert.NotEqual(t, v1.Key(), v2.Key(), "%s %s", v1, v2)
+	}
+}
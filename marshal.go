@@ -0,0 +1,22 @@
+package version
+
+import (
+	"golang.org/x/xerrors"
+)
+
+// MarshalText returns the original version string.
+// It is also used by encoding/json, so a Version is encoded as a JSON string.
+func (v1 Version) MarshalText() ([]byte, error) {
+	return []byte(v1.Value), nil
+}
+
+// UnmarshalText parses text as NewVersion with WithStrict does.
+// The default Scheme and ModeSpec are used.
+func (v1 *Version) UnmarshalText(text []byte) error {
+	v, err := NewVersion(string(text), WithStrict())
+	if err != nil {
+		return xerrors.Errorf("failed to unmarshal version: %w", err)
+	}
+	*v1 = v
+	return nil
+}
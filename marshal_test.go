@@ -0,0 +1,82 @@
+package version_test
+
+import (
+	"encoding/json"
+	"flag"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+func TestVersion_MarshalText(t *testing.T) {
+	v, err := version.NewVersion("1.0.0.RELEASE")
+	require.NoError(t, err)
+
+	text, err := v.MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "1.0.0.RELEASE", string(text))
+
+	var got version.Version
+	require.NoError(t, got.UnmarshalText(text))
+	assert.Equal(t, v.Value, got.Value)
+	assert.True(t, v.Equal(got))
+}
+
+func TestVersion_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		text    string
+		wantErr error
+	}{
+		{"1.2.3-SNAPSHOT", nil},
+		{"", version.ErrEmptyVersion},
+		{"1.0 ", version.ErrInvalidCharacter},
+		{"1.0/2", version.ErrInvalidCharacter},
+	}
+	for _, tt := range tests {
+		t.Run(tt.text, func(t *testing.T) {
+			var v version.Version
+			err := v.UnmarshalText([]byte(tt.text))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.text, v.String())
+		})
+	}
+}
+
+func TestVersion_JSON(t *testing.T) {
+	type artifact struct {
+		Name    string            `json:"name"`
+		Version version.Version   `json:"version"`
+		Fixed   []version.Version `json:"fixed"`
+	}
+
+	in := `{"name":"spring-core","version":"5.3.20","fixed":["5.3.21","6.0.0-RC1"]}`
+	var a artifact
+	require.NoError(t, json.Unmarshal([]byte(in), &a))
+	assert.Equal(t, "5.3.20", a.Version.String())
+	require.Len(t, a.Fixed, 2)
+	assert.True(t, a.Version.LessThan(a.Fixed[0]))
+	assert.True(t, a.Fixed[0].LessThan(a.Fixed[1]))
+
+	out, err := json.Marshal(a)
+	require.NoError(t, err)
+	assert.JSONEq(t, in, string(out))
+
+	err = json.Unmarshal([]byte(`{"version":"5.3.20:1"}`), &a)
+	assert.ErrorIs(t, err, version.ErrInvalidCharacter)
+}
+
+func TestVersion_Flag(t *testing.T) {
+	var v version.Version
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.TextVar(&v, "version", version.Version{}, "version")
+	require.NoError(t, fs.Parse([]string{"-version", "2.13.4"}))
+	assert.Equal(t, "2.13.4", v.String())
+	assert.Equal(t, 2, v.Major())
+}
@@ -0,0 +1,186 @@
+package version
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Mode selects the rules used to parse a version string.
+type Mode int
+
+const (
+	// ModeSpec follows the maven version order specification.
+	ModeSpec Mode = iota
+	// ModeMaven reproduces ComparableVersion of maven 3.9.
+	// It parses a qualifier immediately followed by a number as a CombinationItem ("alpha1", "alpha-1"),
+	// treats ".X" as "-X" for a trailing qualifier, and only removes zeros that are followed by a qualifier,
+	// so "2.0.a" and "2.0.0.a" are equal (MNG-6420, MNG-7644).
+	ModeMaven
+)
+
+// CombinationItem is a qualifier immediately followed by a number, e.g. "alpha1".
+// It is only produced by ModeMaven.
+type CombinationItem struct {
+	stringPart StringItem
+	digitPart  Item
+}
+
+func newCombinationItem(value string, scheme *Scheme) CombinationItem {
+	index := 0
+	for i := 0; i < len(value); i++ {
+		if isDigitByte(value[i]) {
+			index = i
+			break
+		}
+	}
+	return CombinationItem{
+		stringPart: scheme.newStringItem(value[:index], true),
+		digitPart:  newIntItem(value[index:]),
+	}
+}
+
+func (item1 CombinationItem) Compare(item2 Item) int {
+	if item2 == nil {
+		// 1-rc1 < 1, 1-ga1 > 1
+		return item1.stringPart.Compare(item2)
+	}
+
+	switch v := item2.(type) {
+	case IntItem, BigIntItem:
+		return -1
+	case StringItem:
+		if result := item1.stringPart.Compare(v); result != 0 {
+			return result
+		}
+		return 1 // X1 > X
+	case CombinationItem:
+		if result := item1.stringPart.Compare(v.stringPart); result != 0 {
+			return result
+		}
+		return item1.digitPart.Compare(v.digitPart)
+	case ListItem:
+		return -1
+	}
+	return 0
+}
+
+func (item1 CombinationItem) isNull() bool {
+	return false
+}
+
+func (item1 CombinationItem) String() string {
+	return item1.stringPart.String() + fmt.Sprint(item1.digitPart)
+}
+
+// normalizeMaven removes zeros and empty qualifiers that are at the end of the list,
+// or that are followed by a qualifier.
+func (items1 ListItem) normalizeMaven() ListItem {
+	ret := items1
+	for i := len(ret) - 1; i >= 0; i-- {
+		if !ret[i].isNull() {
+			continue
+		}
+		if i == len(ret)-1 || isQualifierItem(ret[i+1]) {
+			ret = append(ret[:i], ret[i+1:]...)
+		} else if l, ok := ret[i+1].(ListItem); ok && len(l) > 0 && isQualifierItem(l[0]) {
+			ret = append(ret[:i], ret[i+1:]...)
+		}
+	}
+	return ret
+}
+
+func isQualifierItem(item Item) bool {
+	switch item.(type) {
+	case StringItem, CombinationItem:
+		return true
+	}
+	return false
+}
+
+// parseVersionMaven parses v as ComparableVersion.parseVersion of maven 3.9 does.
+func parseVersionMaven(v string, scheme *Scheme) ListItem {
+	stack := new(ListItemStack)
+	var list ListItem
+
+	isDigit := false
+	isCombination := false
+	startIndex := 0
+	str := toLowerASCII(v)
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if c == '-' && scheme.dashAsDot {
+			c = '.'
+		}
+		if c == '.' {
+			if i == startIndex {
+				list = append(list, IntItem(0))
+			} else {
+				list = append(list, parseMavenItem(isCombination, isDigit, str[startIndex:i], scheme))
+			}
+			isCombination = false
+			startIndex = i + 1
+		} else if c == '-' {
+			if i == startIndex {
+				list = append(list, IntItem(0))
+			} else {
+				// X-1 is going to be treated as X1
+				if !isDigit && i != len(str)-1 && isDigitByte(str[i+1]) {
+					isCombination = true
+					continue
+				}
+				list = append(list, parseMavenItem(isCombination, isDigit, str[startIndex:i], scheme))
+			}
+			startIndex = i + 1
+
+			stack.Push(list)
+			list = ListItem{}
+			isCombination = false
+		} else if isDigitByte(c) {
+			if !isDigit && i > startIndex {
+				// X1
+				isCombination = true
+
+				if len(list) > 0 {
+					stack.Push(list)
+					list = ListItem{}
+				}
+			}
+			isDigit = true
+		} else {
+			if isDigit && i > startIndex {
+				list = append(list, parseMavenItem(isCombination, true, str[startIndex:i], scheme))
+				startIndex = i
+
+				stack.Push(list)
+				list = ListItem{}
+				isCombination = false
+			}
+			isDigit = false
+		}
+	}
+	if len(str) > startIndex {
+		// 1.0.0.X1 < 1.0.0-X2, treat .X as -X for any string qualifier X
+		if !isDigit && len(list) > 0 {
+			stack.Push(list)
+			list = ListItem{}
+		}
+		list = append(list, parseMavenItem(isCombination, isDigit, str[startIndex:], scheme))
+	}
+
+	ret := list.normalizeMaven()
+	for !stack.IsEmpty() {
+		ret = append(stack.Pop(), ret).normalizeMaven()
+	}
+	return ret
+}
+
+func parseMavenItem(isCombination, isDigit bool, item string, scheme *Scheme) Item {
+	if isCombination {
+		return newCombinationItem(strings.ReplaceAll(item, "-", ""), scheme)
+	}
+	return parseItem(isDigit, item, scheme)
+}
+
+func isDigitByte(c byte) bool {
+	return '0' <= c && c <= '9'
+}
@@ -0,0 +1,128 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+// The cases are taken from ComparableVersionTest of maven 3.9.
+
+func newMavenVersion(t *testing.T, v string) version.Version {
+	t.Helper()
+	ver, err := version.NewVersion(v, version.WithMode(version.ModeMaven))
+	require.NoError(t, err)
+	return ver
+}
+
+func checkMavenVersionsOrder(t *testing.T, versions []string) {
+	t.Helper()
+	for i := 1; i < len(versions); i++ {
+		low := newMavenVersion(t, versions[i-1])
+		for j := i; j < len(versions); j++ {
+			high := newMavenVersion(t, versions[j])
+			assert.True(t, low.LessThan(high), "expected: %s < %s", low, high)
+			assert.True(t, high.GreaterThan(low), "expected: %s > %s", high, low)
+		}
+	}
+}
+
+func checkMavenVersionsEqual(t *testing.T, v1, v2 string) {
+	t.Helper()
+	assert.True(t, newMavenVersion(t, v1).Equal(newMavenVersion(t, v2)), "expected: %s == %s", v1, v2)
+	assert.True(t, newMavenVersion(t, v2).Equal(newMavenVersion(t, v1)), "expected: %s == %s", v2, v1)
+}
+
+func TestModeMaven_VersionsQualifier(t *testing.T) {
+	checkMavenVersionsOrder(t, []string{
+		"1-alpha2snapshot", "1-alpha2", "1-alpha-123", "1-beta-2", "1-beta123", "1-m2", "1-m11", "1-rc", "1-cr2",
+		"1-rc123", "1-SNAPSHOT", "1", "1-sp", "1-sp2", "1-sp123", "1-abc", "1-def", "1-pom-1", "1-1-snapshot",
+		"1-1", "1-2", "1-123",
+	})
+}
+
+func TestModeMaven_VersionsNumber(t *testing.T) {
+	checkMavenVersionsOrder(t, []string{
+		"2.0", "2.0.a", "2-1", "2.0.2", "2.0.123", "2.1.0", "2.1-a", "2.1b", "2.1-c", "2.1-1", "2.1.0.1", "2.2",
+		"2.123", "11.a2", "11.a11", "11.b2", "11.b11", "11.m2", "11.m11", "11", "11.a", "11b", "11c", "11m",
+	})
+}
+
+func TestModeMaven_VersionsEqual(t *testing.T) {
+	equals := [][2]string{
+		{"1", "1"}, {"1", "1.0"}, {"1", "1.0.0"}, {"1.0", "1.0.0"}, {"1", "1-0"}, {"1", "1.0-0"}, {"1.0", "1.0-0"},
+		// no separator between number and character
+		{"1a", "1-a"}, {"1a", "1.0-a"}, {"1a", "1.0.0-a"}, {"1.0a", "1-a"}, {"1.0.0a", "1-a"},
+		{"1x", "1-x"}, {"1x", "1.0-x"}, {"1x", "1.0.0-x"}, {"1.0x", "1-x"}, {"1.0.0x", "1-x"}, {"1cr", "1rc"},
+		// special "aliases" a, b and m for alpha, beta and milestone
+		{"1a1", "1-alpha-1"}, {"1b2", "1-beta-2"}, {"1m3", "1-milestone-3"},
+		// case insensitive
+		{"1X", "1x"}, {"1A", "1a"}, {"1B", "1b"}, {"1M", "1m"}, {"1Ga", "1"}, {"1GA", "1"}, {"1RELEASE", "1"},
+		{"1release", "1"}, {"1RELeaSE", "1"}, {"1Final", "1"}, {"1FinaL", "1"}, {"1CR", "1rc"}, {"1cR", "1rc"},
+		{"1m3", "1Milestone3"}, {"1m3", "1MileStone3"}, {"1m3", "1MILESTONE3"},
+	}
+	for _, tt := range equals {
+		checkMavenVersionsEqual(t, tt[0], tt[1])
+	}
+}
+
+func TestModeMaven_VersionComparing(t *testing.T) {
+	orders := [][2]string{
+		{"1", "2"}, {"1.5", "2"}, {"1", "2.5"}, {"1.0", "1.1"}, {"1.1", "1.2"}, {"1.0.0", "1.1"}, {"1.0.1", "1.1"},
+		{"1.1", "1.2.0"}, {"1.0-alpha-1", "1.0"}, {"1.0-alpha-1", "1.0-alpha-2"}, {"1.0-alpha-1", "1.0-beta-1"},
+		{"1.0-beta-1", "1.0-SNAPSHOT"}, {"1.0-SNAPSHOT", "1.0"}, {"1.0-alpha-1-SNAPSHOT", "1.0-alpha-1"},
+		{"1.0", "1.0-1"}, {"1.0-1", "1.0-2"}, {"1.0.0", "1.0-1"}, {"2.0-1", "2.0.1"}, {"2.0.1-klm", "2.0.1-lmn"},
+		{"2.0.1", "2.0.1-xyz"}, {"2.0.1", "2.0.1-123"}, {"2.0.1-xyz", "2.0.1-123"},
+		// MNG-6572
+		{"20190126.230843", "1234567890.12345"}, {"1234567890.12345", "123456789012345.1H.5-beta-X"},
+		{"123456789012345.1H.5-beta-X", "12345678901234567890.1H.5-beta-X"},
+		// MNG-6964
+		{"1-0.alpha", "1"}, {"1-0.beta", "1"}, {"1-0.alpha", "1-0.beta"},
+	}
+	for _, tt := range orders {
+		checkMavenVersionsOrder(t, tt[:])
+	}
+}
+
+func TestModeMaven_MNG7644(t *testing.T) {
+	for _, x := range []string{"abc", "alpha", "a", "beta", "b", "def", "milestone", "m", "RC"} {
+		// 1.0.0.X1 < 1.0.0-X2 for any string x
+		checkMavenVersionsOrder(t, []string{"1.0.0." + x + "1", "1.0.0-" + x + "2"})
+		// 2.0.X == 2-X == 2.0.0.X for any string x
+		checkMavenVersionsEqual(t, "2-"+x, "2.0."+x)
+		checkMavenVersionsEqual(t, "2-"+x, "2.0.0."+x)
+		checkMavenVersionsEqual(t, "2.0."+x, "2.0.0."+x)
+	}
+}
+
+func TestModeMaven_MNG6420(t *testing.T) {
+	// the spec orders 2.0.a < 2.0.0.a, maven treats them as equal
+	checkMavenVersionsEqual(t, "2.0.a", "2.0.0.a")
+
+	spec1, err := version.NewVersion("2.0.a")
+	require.NoError(t, err)
+	spec2, err := version.NewVersion("2.0.0.a")
+	require.NoError(t, err)
+	assert.False(t, spec1.Equal(spec2))
+}
+
+func TestModeMaven_Canonical(t *testing.T) {
+	tests := []struct {
+		v    string
+		want string
+	}{
+		{"1.0-alpha1", "1-alpha1"},
+		{"1-alpha-1", "1-alpha1"},
+		{"1.0.0.RELEASE", "1"},
+		{"2.0.0.a", "2-a"},
+		{"1.0-1", "1.0-1"},
+		{"1.0.0-SNAPSHOT", "1-snapshot"},
+		{"1-1.ga", "1-1"},
+	}
+	for _, tt := range tests {
+		assert.Equal(t, tt.want, newMavenVersion(t, tt.v).Canonical(), tt.v)
+	}
+}
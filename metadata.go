@@ -0,0 +1,47 @@
+package version
+
+import (
+	"strings"
+)
+
+// BuildMetadata returns the build metadata after the first "+", e.g. "build.1" for "1.0+build.1",
+// if the version is parsed WithBuildMetadata. It returns "" otherwise.
+// The build metadata is ignored by Compare, as in Semantic Versioning,
+// and is not kept by the versions derived from this one, such as NextMinor and ToSnapshot.
+func (v1 Version) BuildMetadata() string {
+	_, metadata, _ := v1.splitBuildMetadata()
+	return metadata
+}
+
+// withoutBuildMetadata returns Value without the build metadata.
+func (v1 Version) withoutBuildMetadata() string {
+	v, _, _ := v1.splitBuildMetadata()
+	return v
+}
+
+func (v1 Version) splitBuildMetadata() (v, metadata string, found bool) {
+	if !v1.opts.buildMetadata {
+		return v1.Value, "", false
+	}
+	return strings.Cut(v1.Value, "+")
+}
+
+// validateBuildMetadata checks the build metadata of v for strict parsing.
+// It must be dot separated identifiers of ASCII letters, digits and "-", as in Semantic Versioning.
+func validateBuildMetadata(v string, pos int) error {
+	metadata := v[pos:]
+	for i := 0; i < len(metadata); i++ {
+		c := metadata[i]
+		switch {
+		case '0' <= c && c <= '9', 'a' <= c && c <= 'z', 'A' <= c && c <= 'Z', c == '-':
+			continue
+		case c == '.' && i > 0 && i < len(metadata)-1 && metadata[i-1] != '.':
+			continue
+		}
+		return &ParseError{Version: v, Pos: pos + i, Err: ErrInvalidCharacter}
+	}
+	if metadata == "" {
+		return &ParseError{Version: v, Pos: pos - 1, Err: ErrInvalidCharacter}
+	}
+	return nil
+}
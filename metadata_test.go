@@ -0,0 +1,105 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+func TestVersion_BuildMetadata(t *testing.T) {
+	tests := []struct {
+		v        string
+		wantMeta string
+		wantBase string
+	}{
+		{"1.0+build.1", "build.1", "1.0"},
+		{"1.0.0-RC1+exp.sha.5114f85", "exp.sha.5114f85", "1.0.0-RC1"},
+		{"1.0+a+b", "a+b", "1.0"},
+		{"1.0", "", "1.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			v := mustVersion(t, tt.v, version.WithBuildMetadata())
+			assert.Equal(t, tt.wantMeta, v.BuildMetadata())
+			assert.Equal(t, tt.v, v.String())
+			assert.True(t, v.Equal(mustVersion(t, tt.wantBase)))
+		})
+	}
+
+	// without the option, "+" is a part of the version
+	v := mustVersion(t, "1.0+build.1")
+	assert.Equal(t, "", v.BuildMetadata())
+	assert.False(t, v.Equal(mustVersion(t, "1.0")))
+}
+
+func TestVersion_BuildMetadataCompare(t *testing.T) {
+	v1 := mustVersion(t, "1.0+build.1", version.WithBuildMetadata())
+	v2 := mustVersion(t, "1.0+build.2", version.WithBuildMetadata())
+	v3 := mustVersion(t, "1.0.1+build.1", version.WithBuildMetadata())
+
+	assert.Equal(t, 0, v1.Compare(v2))
+	assert.True(t, v1.Equal(v2))
+	assert.Equal(t, v1.Key(), v2.Key())
+	assert.Equal(t, v1.SortKey(), v2.SortKey())
+	assert.True(t, v1.LessThan(v3))
+
+	c, err := version.NewConstraints("= 1.0", version.WithBuildMetadata())
+	require.NoError(t, err)
+	assert.True(t, c.Check(v1))
+	assert.False(t, c.Check(v3))
+}
+
+func TestVersion_BuildMetadataStrict(t *testing.T) {
+	opts := []version.Option{version.WithBuildMetadata(), version.WithStrict()}
+
+	for _, v := range []string{"1.0+build.1", "1.0+20231010.sha-5114f85", "1.0-SNAPSHOT+1"} {
+		_, err := version.NewVersion(v, opts...)
+		assert.NoError(t, err, v)
+	}
+
+	tests := []struct {
+		v       string
+		wantPos int
+	}{
+		{"1.0+", 3},
+		{"1.0+build..1", 10},
+		{"1.0+.build", 4},
+		{"1.0+build.", 9},
+		{"1.0+build_1", 9},
+		{"1.0+a+b", 5},
+		{"1.0 beta+build", 3},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			_, err := version.NewVersion(tt.v, opts...)
+			require.ErrorIs(t, err, version.ErrInvalidCharacter)
+			var perr *version.ParseError
+			require.ErrorAs(t, err, &perr)
+			assert.Equal(t, tt.wantPos, perr.Pos)
+		})
+	}
+}
+
+func TestVersion_BuildMetadataDerived(t *testing.T) {
+	opt := version.WithBuildMetadata()
+
+	v := mustVersion(t, "1.2.3+build.1", opt)
+	next, err := v.NextMinor()
+	require.NoError(t, err)
+	assert.Equal(t, "1.3.0", next.String())
+
+	snapshot, err := v.ToSnapshot()
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.3-SNAPSHOT", snapshot.String())
+
+	release, err := mustVersion(t, "1.2.3-SNAPSHOT+build.1", opt).ToRelease()
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.3", release.String())
+
+	ts := mustVersion(t, "1.0-20220516.123456-7+build.1", opt)
+	assert.True(t, ts.IsTimestampedSnapshot())
+	assert.Equal(t, "1.0-SNAPSHOT", ts.BaseVersion().String())
+}
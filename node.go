@@ -0,0 +1,169 @@
+package version
+
+// Kind is the kind of a Node.
+type Kind int
+
+const (
+	// KindList is a list of nodes, the root and the parts after "-".
+	KindList Kind = iota
+	// KindNumber is a number of any size.
+	KindNumber
+	// KindQualifier is a qualifier, "" for the release.
+	KindQualifier
+	// KindCombination is a qualifier immediately followed by a number, e.g. "alpha1", only in ModeMaven.
+	// Its children are the qualifier and the number.
+	KindCombination
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindList:
+		return "list"
+	case KindNumber:
+		return "number"
+	case KindQualifier:
+		return "qualifier"
+	case KindCombination:
+		return "combination"
+	}
+	return "unknown"
+}
+
+// Node is a read-only view of an item of a parsed version.
+// Unlike Version.Items, it can not be used to modify the version, so it is safe to pass around.
+type Node struct {
+	item Item
+}
+
+// Root returns the root node of the normalized items, which is a list.
+func (v1 Version) Root() Node {
+	return Node{item: v1.Items}
+}
+
+// Kind returns the kind of the node.
+func (n Node) Kind() Kind {
+	switch n.item.(type) {
+	case IntItem, BigIntItem:
+		return KindNumber
+	case StringItem:
+		return KindQualifier
+	case CombinationItem:
+		return KindCombination
+	}
+	return KindList
+}
+
+// Len returns the number of the children of a list or a combination, and 0 for the others.
+func (n Node) Len() int {
+	switch v := n.item.(type) {
+	case ListItem:
+		return len(v)
+	case CombinationItem:
+		return 2
+	}
+	return 0
+}
+
+// Child returns the i-th child of a list or a combination.
+// It panics if i is out of range.
+func (n Node) Child(i int) Node {
+	switch v := n.item.(type) {
+	case ListItem:
+		return Node{item: v[i]}
+	case CombinationItem:
+		switch i {
+		case 0:
+			return Node{item: v.stringPart}
+		case 1:
+			return Node{item: v.digitPart}
+		}
+	}
+	panic("version: child index out of range")
+}
+
+// Number returns the decimal digits of a number without leading zeros, e.g. "0" or "20220516".
+// It returns "" for the other kinds.
+func (n Node) Number() string {
+	switch v := n.item.(type) {
+	case IntItem:
+		return v.String()
+	case BigIntItem:
+		return v.String()
+	}
+	return ""
+}
+
+// Int returns the value of a number, and false if it is not a number or does not fit in an int.
+func (n Node) Int() (int, bool) {
+	v, ok := n.item.(IntItem)
+	return int(v), ok
+}
+
+// Qualifier returns the normalized name of a qualifier, e.g. "rc" for "CR", and "" for the others.
+func (n Node) Qualifier() string {
+	if v, ok := n.item.(StringItem); ok {
+		return v.value
+	}
+	return ""
+}
+
+// Rank returns the index of a qualifier in the scheme, and false if it is unknown or not a qualifier.
+func (n Node) Rank() (int, bool) {
+	v, ok := n.item.(StringItem)
+	if !ok {
+		return 0, false
+	}
+	return v.rank()
+}
+
+// IsNull returns true if the node is equal to the padding of a shorter version, e.g. 0, the release and an empty list.
+func (n Node) IsNull() bool {
+	return n.item == nil || n.item.Compare(nil) == 0
+}
+
+// String renders the node as Canonical does.
+func (n Node) String() string {
+	switch v := n.item.(type) {
+	case IntItem:
+		return v.String()
+	case BigIntItem:
+		return v.String()
+	case StringItem:
+		return v.String()
+	case CombinationItem:
+		return v.String()
+	case ListItem:
+		return v.String()
+	}
+	return ""
+}
+
+// Compare compares the nodes as the items of versions are compared.
+func (n Node) Compare(n2 Node) int {
+	if n.item == nil {
+		if n2.item == nil {
+			return 0
+		}
+		return -n2.item.Compare(nil)
+	}
+	return n.item.Compare(n2.item)
+}
+
+// WalkFunc is called by Walk for each node with the path of the child indexes from the root.
+// The path is only valid during the call.
+// If it returns false, the children of the node are skipped.
+type WalkFunc func(path []int, n Node) bool
+
+// Walk calls fn for root and all its descendants in depth-first order.
+func Walk(root Node, fn WalkFunc) {
+	walk(nil, root, fn)
+}
+
+func walk(path []int, n Node, fn WalkFunc) {
+	if !fn(path, n) {
+		return
+	}
+	for i := 0; i < n.Len(); i++ {
+		walk(append(path, i), n.Child(i), fn)
+	}
+}
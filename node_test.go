@@ -0,0 +1,119 @@
+package version_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+func TestWalk(t *testing.T) {
+	tests := []struct {
+		v    string
+		opts []version.Option
+		want []string
+	}{
+		{
+			v: "1.2-RC1",
+			want: []string{
+				"[] list 1.2-rc-1",
+				"[0] number 1",
+				"[1] number 2",
+				"[2] list rc-1",
+				"[2 0] qualifier rc rank=3",
+				"[2 1] list 1",
+				"[2 1 0] number 1",
+			},
+		},
+		{
+			v:    "1-alpha1.ga.99999999999999999999",
+			opts: []version.Option{version.WithMode(version.ModeMaven)},
+			want: []string{
+				"[] list 1-alpha1..99999999999999999999",
+				"[0] number 1",
+				"[1] list alpha1..99999999999999999999",
+				"[1 0] combination alpha1",
+				"[1 0 0] qualifier alpha rank=0",
+				"[1 0 1] number 1",
+				"[1 1] qualifier  rank=5 null",
+				"[1 2] number 99999999999999999999",
+			},
+		},
+		{
+			v:    "",
+			want: []string{"[] list  null"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			v, err := version.NewVersion(tt.v, tt.opts...)
+			require.NoError(t, err)
+
+			var got []string
+			version.Walk(v.Root(), func(path []int, n version.Node) bool {
+				s := fmt.Sprintf("%v %s %s", path, n.Kind(), n)
+				if n.Kind() == version.KindQualifier {
+					rank, _ := n.Rank()
+					s += fmt.Sprintf(" rank=%d", rank)
+				}
+				if n.IsNull() {
+					s += " null"
+				}
+				got = append(got, s)
+				return true
+			})
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWalk_Skip(t *testing.T) {
+	v, err := version.NewVersion("1.2.3-beta-4")
+	require.NoError(t, err)
+
+	var numbers []int
+	version.Walk(v.Root(), func(path []int, n version.Node) bool {
+		if i, ok := n.Int(); ok {
+			numbers = append(numbers, i)
+		}
+		// skip the qualifiers
+		return len(path) == 0
+	})
+	assert.Equal(t, []int{1, 2, 3}, numbers)
+}
+
+func TestNode(t *testing.T) {
+	v, err := version.NewVersion("1.0-CR-99999999999999999999")
+	require.NoError(t, err)
+
+	root := v.Root()
+	assert.Equal(t, version.KindList, root.Kind())
+	assert.Equal(t, 2, root.Len())
+
+	n := root.Child(0)
+	assert.Equal(t, version.KindNumber, n.Kind())
+	assert.Equal(t, "1", n.Number())
+	i, ok := n.Int()
+	assert.True(t, ok)
+	assert.Equal(t, 1, i)
+
+	q := root.Child(1).Child(0)
+	assert.Equal(t, version.KindQualifier, q.Kind())
+	assert.Equal(t, "rc", q.Qualifier())
+	rank, ok := q.Rank()
+	assert.True(t, ok)
+	assert.Equal(t, 3, rank)
+	assert.Equal(t, "", q.Number())
+
+	big := root.Child(1).Child(1).Child(0)
+	assert.Equal(t, "99999999999999999999", big.Number())
+	_, ok = big.Int()
+	assert.False(t, ok)
+	assert.Equal(t, 1, big.Compare(n))
+	assert.Equal(t, -1, q.Compare(version.Node{}))
+
+	assert.Panics(t, func() { n.Child(0) })
+}
@@ -0,0 +1,75 @@
+package version
+
+// Option configures how a version string is parsed.
+type Option func(*options)
+
+type options struct {
+	strict         bool
+	scheme         *Scheme
+	mode           Mode
+	calendarPolicy CalendarPolicy
+	buildMetadata  bool
+}
+
+func newOptions(opts []Option) options {
+	o := options{
+		scheme: defaultScheme,
+	}
+	if len(opts) == 0 {
+		// o is not passed to the options, so that it does not escape to the heap
+		return o
+	}
+
+	p := new(options)
+	*p = o
+	for _, opt := range opts {
+		opt(p)
+	}
+	return *p
+}
+
+// WithStrict rejects malformed version strings instead of parsing them leniently.
+// A strict version is non-empty and consists only of ASCII letters, digits and "._+~-".
+// The rejection is reported as a *ParseError.
+func WithStrict() Option {
+	return func(o *options) {
+		o.strict = true
+	}
+}
+
+// WithScheme orders qualifiers by s instead of DefaultScheme.
+// Versions parsed with different schemes should not be compared with each other.
+func WithScheme(s *Scheme) Option {
+	return func(o *options) {
+		if s != nil {
+			o.scheme = s
+		}
+	}
+}
+
+// WithMode selects the parsing rules, ModeSpec by default.
+// Versions parsed with different modes should not be compared with each other.
+func WithMode(m Mode) Option {
+	return func(o *options) {
+		o.mode = m
+	}
+}
+
+// WithCalendarPolicy selects how versions based on a date are ordered against the others, CalendarMixed by default.
+// e.g. with CalendarDateBelow, "20040616" of commons-collections is lower than "3.2.2".
+// Versions parsed with different policies should not be compared.
+// If they are, the policy ranking more versions below is used for both, so that the order is still antisymmetric.
+func WithCalendarPolicy(p CalendarPolicy) Option {
+	return func(o *options) {
+		o.calendarPolicy = p
+	}
+}
+
+// WithBuildMetadata splits off the build metadata after the first "+", e.g. "build.1" of "1.0+build.1",
+// which is ignored for ordering and equality, as in Semantic Versioning, and is returned by BuildMetadata.
+// With WithStrict, the build metadata must be dot separated identifiers of ASCII letters, digits and "-".
+func WithBuildMetadata() Option {
+	return func(o *options) {
+		o.buildMetadata = true
+	}
+}
@@ -0,0 +1,91 @@
+package version
+
+import (
+	"container/list"
+	"sync"
+)
+
+// Parser parses versions with the same options, and interns the parsed versions,
+// so that a repeated version string is parsed only once.
+// The size of the cache is bounded, and the least recently used version is evicted first.
+// A Parser is safe for concurrent use.
+//
+// The versions returned for the same string are shared, immutable versions:
+// their Items, which are deprecated in favor of Root, must not be modified.
+// Use Clone for a version with its own copy of Items.
+type Parser struct {
+	opts options
+	size int
+
+	mu     sync.Mutex
+	cache  map[string]*list.Element
+	lru    *list.List
+	hits   uint64
+	misses uint64
+}
+
+// ParserStats is the statistics of the cache of a Parser.
+type ParserStats struct {
+	// Hits is the number of versions returned from the cache.
+	Hits uint64
+	// Misses is the number of versions parsed, including the invalid ones.
+	Misses uint64
+	// Len is the number of versions in the cache.
+	Len int
+}
+
+// NewParser returns a Parser which caches up to size versions, parsed with opts.
+// A size of 0 or less disables the cache.
+func NewParser(size int, opts ...Option) *Parser {
+	return &Parser{
+		opts:  newOptions(opts),
+		size:  size,
+		cache: map[string]*list.Element{},
+		lru:   list.New(),
+	}
+}
+
+// Parse returns the version of v as NewVersion does, from the cache if v was parsed before.
+// Invalid versions are not cached.
+func (p *Parser) Parse(v string) (Version, error) {
+	p.mu.Lock()
+	if e, ok := p.cache[v]; ok {
+		p.lru.MoveToFront(e)
+		p.hits++
+		p.mu.Unlock()
+		return e.Value.(Version), nil
+	}
+	p.misses++
+	p.mu.Unlock()
+
+	ver, err := newVersion(v, p.opts)
+	if err != nil || p.size <= 0 {
+		return ver, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if e, ok := p.cache[v]; ok {
+		// parsed by another goroutine in the meantime
+		p.lru.MoveToFront(e)
+		return e.Value.(Version), nil
+	}
+	p.cache[v] = p.lru.PushFront(ver)
+	for p.lru.Len() > p.size {
+		e := p.lru.Back()
+		p.lru.Remove(e)
+		delete(p.cache, e.Value.(Version).Value)
+	}
+	return ver, nil
+}
+
+// Stats returns the statistics of the cache.
+func (p *Parser) Stats() ParserStats {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return ParserStats{
+		Hits:   p.hits,
+		Misses: p.misses,
+		Len:    p.lru.Len(),
+	}
+}
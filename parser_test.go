@@ -0,0 +1,114 @@
+package version_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+func TestParser_Parse(t *testing.T) {
+	p := version.NewParser(2)
+
+	for _, v := range []string{"1.0", "2.13.4", "1.0", "1.0", "2.13.4"} {
+		got, err := p.Parse(v)
+		require.NoError(t, err)
+		want, err := version.NewVersion(v)
+		require.NoError(t, err)
+		assert.Equal(t, want, got)
+	}
+	assert.Equal(t, version.ParserStats{Hits: 3, Misses: 2, Len: 2}, p.Stats())
+
+	// "1.0" is the least recently used one
+	_, err := p.Parse("5.3.20")
+	require.NoError(t, err)
+	_, err = p.Parse("2.13.4")
+	require.NoError(t, err)
+	_, err = p.Parse("1.0")
+	require.NoError(t, err)
+	assert.Equal(t, version.ParserStats{Hits: 4, Misses: 4, Len: 2}, p.Stats())
+}
+
+func TestParser_Options(t *testing.T) {
+	p := version.NewParser(10, version.WithStrict(), version.WithMode(version.ModeMaven))
+
+	v, err := p.Parse("1-alpha-1")
+	require.NoError(t, err)
+	assert.Equal(t, "1-alpha1", v.Canonical())
+
+	for i := 0; i < 2; i++ {
+		_, err = p.Parse("1.0 beta")
+		assert.ErrorIs(t, err, version.ErrInvalidCharacter)
+	}
+	assert.Equal(t, version.ParserStats{Hits: 0, Misses: 3, Len: 1}, p.Stats())
+}
+
+func TestParser_Clone(t *testing.T) {
+	p := version.NewParser(10)
+
+	v1, err := p.Parse("1.2-rc-1")
+	require.NoError(t, err)
+	v2, err := p.Parse("1.2-rc-1")
+	require.NoError(t, err)
+	// the versions are shared
+	assert.Same(t, &v1.Items[0], &v2.Items[0])
+
+	c := v1.Clone()
+	assert.Equal(t, v1, c)
+	c.Items[0] = c.Items[1]
+	c.Items[2].(version.ListItem)[0] = c.Items[1]
+
+	v3, err := p.Parse("1.2-rc-1")
+	require.NoError(t, err)
+	assert.Equal(t, "1.2-rc-1", v3.Canonical())
+	assert.Equal(t, version.ParserStats{Hits: 2, Misses: 1, Len: 1}, p.Stats())
+}
+
+func TestParser_NoCache(t *testing.T) {
+	p := version.NewParser(0)
+	for i := 0; i < 3; i++ {
+		v, err := p.Parse("1.0")
+		require.NoError(t, err)
+		assert.Equal(t, "1.0", v.String())
+	}
+	assert.Equal(t, version.ParserStats{Hits: 0, Misses: 3, Len: 0}, p.Stats())
+}
+
+func TestParser_Concurrent(t *testing.T) {
+	p := version.NewParser(5)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				s := fmt.Sprintf("1.%d", j%10)
+				v, err := p.Parse(s)
+				require.NoError(t, err)
+				assert.Equal(t, s, v.String())
+			}
+		}()
+	}
+	wg.Wait()
+
+	stats := p.Stats()
+	assert.Equal(t, uint64(800), stats.Hits+stats.Misses)
+	assert.Equal(t, 5, stats.Len)
+}
+
+func BenchmarkParser_Parse(b *testing.B) {
+	p := version.NewParser(100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, v := range benchmarkVersions {
+			if _, err := p.Parse(v); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
@@ -42,13 +42,14 @@ type requirement struct {
 // NewRequirements is return Requirement
 // [1.0.0], [1.0.1]	=> []requirement{"[1.0.0]","[1.0.1]"}
 // [1.0.0]		=> []requirement{"[1.0.0]"}
-func NewRequirements(v string) (Requirements, error) {
+// opts are used to parse the versions in the requirements.
+func NewRequirements(v string, opts ...Option) (Requirements, error) {
 	// trimSpace "[ , 1.0.0]" => "[,1.0.0]"
 	v = trimSpaces(v)
 
 	var rss [][]requirement
 	if softRequirementRegexp.MatchString(v) {
-		r, err := newRequirement(v)
+		r, err := newRequirement(v, opts)
 		if err != nil {
 			return Requirements{}, xerrors.Errorf("improper soft requirements: %v", v)
 		}
@@ -75,7 +76,7 @@ func NewRequirements(v string) (Requirements, error) {
 			return Requirements{}, xerrors.Errorf("improper requirement length: %v", r)
 		}
 		if len(ss) == 1 && checkEqualOperator(ss[0]) {
-			nr, err := newRequirement(ss[0])
+			nr, err := newRequirement(ss[0], opts)
 			if err != nil {
 				return Requirements{}, xerrors.Errorf("failed to parse requirement: %w", err)
 			}
@@ -94,7 +95,7 @@ func NewRequirements(v string) (Requirements, error) {
 		}
 
 		for _, single := range ss {
-			nr, err := newRequirement(single)
+			nr, err := newRequirement(single, opts)
 			if err != nil {
 				return Requirements{}, xerrors.Errorf("failed to parse requirement: %w", err)
 			}
@@ -108,28 +109,28 @@ func NewRequirements(v string) (Requirements, error) {
 	}, nil
 }
 
-func newRequirement(r string) (requirement, error) {
+func newRequirement(r string, opts []Option) (requirement, error) {
 	var v Version
 	var err error
 	var operator operatorFunc
 	switch {
 	case checkEqualOperator(r):
-		v, err = NewVersion(r[1 : len(r)-2])
+		v, err = NewVersion(r[1:len(r)-2], opts...)
 		operator = requirementEqual
 	case strings.HasPrefix(r, "["):
-		v, err = NewVersion(strings.TrimPrefix(r, "["))
+		v, err = NewVersion(strings.TrimPrefix(r, "["), opts...)
 		operator = requirementGreaterThanEqual
 	case strings.HasPrefix(r, "("):
-		v, err = NewVersion(strings.TrimPrefix(r, "("))
+		v, err = NewVersion(strings.TrimPrefix(r, "("), opts...)
 		operator = requirementGreaterThan
 	case strings.HasSuffix(r, "]"):
-		v, err = NewVersion(strings.TrimSuffix(r, "]"))
+		v, err = NewVersion(strings.TrimSuffix(r, "]"), opts...)
 		operator = requirementLessThanEqual
 	case strings.HasSuffix(r, ")"):
-		v, err = NewVersion(strings.TrimSuffix(r, ")"))
+		v, err = NewVersion(strings.TrimSuffix(r, ")"), opts...)
 		operator = requirementLessThan
 	default: // soft requirement
-		v, err = NewVersion(r)
+		v, err = NewVersion(r, opts...)
 		operator = requirementSoftRequirement
 	}
 	if err != nil {
@@ -98,6 +98,8 @@ func TestRequirementsCheck(t *testing.T) {
 		{"[0,)", "0.9", true},
 		{"[0,)", "1.0.0", true},
 		{"[0,)", "1.0.1", true},
+		{"[0,)", "9223372036854775807", true},
+		{"[0,)", "99999999999999999998", true},
 
 		{"(,0)", "0.9", false},
 		{"(,0)", "1.0.0", false},
@@ -0,0 +1,193 @@
+package version
+
+import (
+	"strconv"
+
+	"golang.org/x/xerrors"
+)
+
+var defaultScheme = mustNewScheme(
+	[]string{"alpha", "beta", "milestone", "rc", "snapshot", "", "sp"},
+	map[string]string{"ga": "", "final": "", "release": "", "cr": "rc"},
+	map[string]string{"a": "alpha", "b": "beta", "m": "milestone"},
+)
+
+// The qualifier settings of DefaultScheme, which were used before Scheme was added.
+// They are copies, so modifying them does not change the order.
+var (
+	// Deprecated: Use DefaultScheme().Qualifiers().
+	Qualifiers = defaultScheme.Qualifiers()
+	// Deprecated: Use DefaultScheme().Aliases().
+	Aliases = defaultScheme.Aliases()
+	// Deprecated: ReleaseVersionIndex is the index of the release qualifier "" in Qualifiers.
+	ReleaseVersionIndex = strconv.Itoa(defaultScheme.release)
+)
+
+// releaseTrainScheme orders the qualifiers of the release trains of Spring.
+var releaseTrainScheme = func() *Scheme {
+	s := mustNewScheme(
+		[]string{"build", "snapshot", "milestone", "rc", "", "sr"},
+		map[string]string{"ga": "", "final": "", "release": "", "cr": "rc"},
+		map[string]string{"m": "milestone"},
+	)
+	s.dashAsDot = true
+	return s
+}()
+
+// Scheme defines how qualifiers are named and ordered.
+// A Scheme is immutable and can be shared between goroutines.
+type Scheme struct {
+	qualifiers []string
+	aliases    map[string]string
+	shorthands map[string]string
+	// ranks maps the known qualifiers to their index in qualifiers.
+	ranks   map[string]int
+	release int
+
+	// items and shorthandItems hold the items of the known names, boxed once to be shared by all versions.
+	items          map[string]Item
+	shorthandItems map[string]Item
+
+	// dashAsDot parses "-" as ".", so that it does not start a list.
+	dashAsDot bool
+}
+
+// NewScheme returns a Scheme.
+// qualifiers lists the known qualifiers from the lowest to the highest, and must contain "" for the release.
+// Unknown qualifiers are ordered after all known ones, lexically.
+// aliases maps a qualifier to another one, e.g. "cr" => "rc".
+// shorthands maps a qualifier that is immediately followed by a digit, e.g. "a1" => "alpha-1".
+// All names are case-insensitive for ASCII letters, as versions are.
+func NewScheme(qualifiers []string, aliases, shorthands map[string]string) (*Scheme, error) {
+	s := &Scheme{
+		aliases:    map[string]string{},
+		shorthands: map[string]string{},
+		ranks:      map[string]int{},
+	}
+	for _, q := range qualifiers {
+		q = toLowerASCII(q)
+		if _, ok := s.ranks[q]; ok {
+			return nil, xerrors.Errorf("duplicate qualifier: %q", q)
+		}
+		s.ranks[q] = len(s.qualifiers)
+		s.qualifiers = append(s.qualifiers, q)
+	}
+
+	release, ok := s.ranks[""]
+	if !ok {
+		return nil, xerrors.New("qualifiers must contain the release qualifier \"\"")
+	}
+	s.release = release
+
+	for k, v := range aliases {
+		s.aliases[toLowerASCII(k)] = toLowerASCII(v)
+	}
+	for k, v := range shorthands {
+		s.shorthands[toLowerASCII(k)] = toLowerASCII(v)
+	}
+
+	s.items = map[string]Item{}
+	for _, q := range s.qualifiers {
+		s.items[q] = s.qualifierItem(q)
+	}
+	for k, v := range s.aliases {
+		s.items[k] = s.qualifierItem(v)
+	}
+	s.shorthandItems = make(map[string]Item, len(s.items)+len(s.shorthands))
+	for k, v := range s.items {
+		s.shorthandItems[k] = v
+	}
+	for k, v := range s.shorthands {
+		s.shorthandItems[k] = s.qualifierItem(v)
+	}
+	return s, nil
+}
+
+func mustNewScheme(qualifiers []string, aliases, shorthands map[string]string) *Scheme {
+	s, err := NewScheme(qualifiers, aliases, shorthands)
+	if err != nil {
+		panic(err)
+	}
+	return s
+}
+
+// DefaultScheme returns the scheme of the maven specification.
+func DefaultScheme() *Scheme {
+	return defaultScheme
+}
+
+// ReleaseTrainScheme returns the scheme of the release trains of Spring Cloud and Spring Data,
+// e.g. "Hoxton.SR3", "Greenwich.RELEASE" and "Moore-BUILD-SNAPSHOT".
+// Release trains are ordered alphabetically by their names, which are unknown qualifiers,
+// then by BUILD-SNAPSHOT < M < RC < RELEASE < SR.
+//
+// Under this scheme "-" is parsed as ".", since the trains use both forms, e.g. "Ilford-M1" and "Ilford.RC1",
+// and a list after "-" would otherwise be greater than any qualifier after ".".
+// So "Moore-SR1" and "Moore.SR1" are equal, and "1-1" and "1.1" are too.
+func ReleaseTrainScheme() *Scheme {
+	return releaseTrainScheme
+}
+
+// Qualifiers returns the known qualifiers from the lowest to the highest.
+func (s *Scheme) Qualifiers() []string {
+	return append([]string(nil), s.qualifiers...)
+}
+
+// Aliases returns the qualifier aliases.
+func (s *Scheme) Aliases() map[string]string {
+	return copyMap(s.aliases)
+}
+
+// Shorthands returns the qualifiers which are expanded when followed by a digit.
+func (s *Scheme) Shorthands() map[string]string {
+	return copyMap(s.shorthands)
+}
+
+func (s *Scheme) newStringItem(value string, followedByDigit bool) StringItem {
+	if followedByDigit {
+		if v, ok := s.shorthands[value]; ok {
+			return s.qualifierItem(v)
+		}
+	}
+
+	if v, ok := s.aliases[value]; ok {
+		return s.qualifierItem(v)
+	}
+	return s.qualifierItem(value)
+}
+
+// qualifierItem returns the StringItem of the lowercased qualifier with its rank.
+func (s *Scheme) qualifierItem(qualifier string) StringItem {
+	item := StringItem{value: qualifier, scheme: s, index: -1}
+	if rank, ok := s.ranks[qualifier]; ok {
+		item.index = rank + 1
+	}
+	return item
+}
+
+// stringItem returns the same item as newStringItem, for a value which is not lowercased yet.
+// The item of a known name is shared, so that only an unknown qualifier is allocated.
+func (s *Scheme) stringItem(value string, followedByDigit bool) Item {
+	items := s.items
+	if followedByDigit {
+		items = s.shorthandItems
+	}
+
+	var buf [32]byte
+	lower := appendLowerASCII(buf[:0], value)
+	if item, ok := items[string(lower)]; ok {
+		return item
+	}
+	if string(lower) == value {
+		return s.qualifierItem(value)
+	}
+	return s.qualifierItem(string(lower))
+}
+
+func copyMap(m map[string]string) map[string]string {
+	ret := make(map[string]string, len(m))
+	for k, v := range m {
+		ret[k] = v
+	}
+	return ret
+}
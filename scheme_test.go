@@ -0,0 +1,224 @@
+package version_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+func TestNewScheme(t *testing.T) {
+	tests := []struct {
+		name       string
+		qualifiers []string
+		wantErr    bool
+	}{
+		{"default", version.DefaultScheme().Qualifiers(), false},
+		{"upper case", []string{"ALPHA", "", "SP"}, false},
+		{"no release", []string{"alpha", "beta"}, true},
+		{"duplicate", []string{"alpha", "", "Alpha"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := version.NewScheme(tt.qualifiers, nil, nil)
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestScheme_Compare(t *testing.T) {
+	// snapshot before any pre-release, "dev" as an alias of snapshot and "p" as a shorthand of "preview"
+	scheme, err := version.NewScheme(
+		[]string{"snapshot", "preview", "alpha", "beta", "rc", "", "sp"},
+		map[string]string{"dev": "snapshot", "ga": ""},
+		map[string]string{"p": "preview"},
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		v1      string
+		v2      string
+		want    int
+		wantDef int
+	}{
+		{"1-snapshot", "1-alpha", -1, 1},
+		{"1-dev", "1-snapshot", 0, 1},
+		{"1-p1", "1-preview-1", 0, -1},
+		{"1-p1", "1-alpha1", -1, 1},
+		{"1-ga", "1", 0, 0},
+		{"1-final", "1", 1, 0},
+		{"1-rc", "1-beta", 1, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v1+" "+tt.v2, func(t *testing.T) {
+			v1, err := version.NewVersion(tt.v1, version.WithScheme(scheme))
+			require.NoError(t, err)
+			v2, err := version.NewVersion(tt.v2, version.WithScheme(scheme))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, v1.Compare(v2))
+
+			d1, err := version.NewVersion(tt.v1)
+			require.NoError(t, err)
+			d2, err := version.NewVersion(tt.v2)
+			require.NoError(t, err)
+			assert.Equal(t, tt.wantDef, d1.Compare(d2))
+		})
+	}
+}
+
+func TestScheme_Concurrent(t *testing.T) {
+	scheme, err := version.NewScheme([]string{"snapshot", "alpha", ""}, nil, nil)
+	require.NoError(t, err)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func(custom bool) {
+			defer wg.Done()
+			var opts []version.Option
+			want := true
+			if custom {
+				opts = append(opts, version.WithScheme(scheme))
+				want = false
+			}
+			for j := 0; j < 100; j++ {
+				c, err := version.NewConstraints(">= 1-alpha", opts...)
+				require.NoError(t, err)
+				v, err := version.NewVersion("1-snapshot", opts...)
+				require.NoError(t, err)
+				assert.Equal(t, want, c.Check(v))
+			}
+		}(i%2 == 0)
+	}
+	wg.Wait()
+}
+
+func TestScheme_Requirements(t *testing.T) {
+	scheme, err := version.NewScheme([]string{"snapshot", "alpha", ""}, nil, nil)
+	require.NoError(t, err)
+
+	r, err := version.NewRequirements("[1-alpha,2)", version.WithScheme(scheme))
+	require.NoError(t, err)
+	v, err := version.NewVersion("1-snapshot", version.WithScheme(scheme))
+	require.NoError(t, err)
+	assert.False(t, r.Check(v))
+
+	c, err := version.NewComparer("[1-snapshot,2)", version.WithScheme(scheme))
+	require.NoError(t, err)
+	assert.True(t, c.Check(v))
+}
+
+func TestScheme_ManyQualifiers(t *testing.T) {
+	// the ranks are compared as numbers, so "ten" (rank 10) ranks after "two" (rank 2)
+	scheme, err := version.NewScheme(
+		[]string{"zero", "one", "two", "three", "four", "five", "six", "seven", "eight", "nine", "ten", "", "eleven"},
+		nil, nil,
+	)
+	require.NoError(t, err)
+
+	tests := []struct {
+		v1   string
+		v2   string
+		want int
+	}{
+		{"1-ten", "1-two", 1},
+		{"1-ten", "1", -1},
+		{"1-eleven", "1", 1},
+		{"1-eleven", "1-nine", 1},
+		{"1-unknown", "1-eleven", 1},
+		{"1-unknown", "1-two", 1},
+		{"1-bar", "1-foo", -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v1+" "+tt.v2, func(t *testing.T) {
+			v1, err := version.NewVersion(tt.v1, version.WithScheme(scheme))
+			require.NoError(t, err)
+			v2, err := version.NewVersion(tt.v2, version.WithScheme(scheme))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, v1.Compare(v2))
+			assert.Equal(t, -tt.want, v2.Compare(v1))
+		})
+	}
+}
+
+func TestReleaseTrainScheme(t *testing.T) {
+	// sorted
+	trains := [][]string{
+		{
+			"Greenwich.BUILD-SNAPSHOT", "Greenwich.M1", "Greenwich.M3", "Greenwich.RC1", "Greenwich.RC2", "Greenwich.RELEASE",
+			"Greenwich.SR1", "Greenwich.SR2", "Greenwich.SR10", "Hoxton.BUILD-SNAPSHOT", "Hoxton.M1", "Hoxton.RELEASE",
+			"Hoxton.SR3", "Ilford.M1",
+		},
+		{
+			"Lovelace-SR5", "Moore-BUILD-SNAPSHOT", "Moore-M1", "Moore-M2", "Moore-RC1", "Moore-RELEASE", "Moore-SR1",
+			"Moore-SR10", "Neumann-M1",
+		},
+	}
+	for _, train := range trains {
+		for i := 1; i < len(train); i++ {
+			v1, err := version.NewVersion(train[i-1], version.WithScheme(version.ReleaseTrainScheme()))
+			require.NoError(t, err)
+			v2, err := version.NewVersion(train[i], version.WithScheme(version.ReleaseTrainScheme()))
+			require.NoError(t, err)
+			assert.Equal(t, -1, v1.Compare(v2), "%s %s", v1, v2)
+			assert.Equal(t, 1, v2.Compare(v1), "%s %s", v2, v1)
+		}
+	}
+
+	// "-" and "." are mixed
+	mixed := []string{
+		"Hoxton-BUILD-SNAPSHOT", "Hoxton.M1", "Hoxton-M2", "Hoxton.RC1", "Hoxton-RELEASE", "Hoxton.SR1", "Hoxton-SR2",
+		"Ilford.BUILD-SNAPSHOT", "Ilford-M1", "Ilford.RC1", "Ilford-RC2", "Ilford.RELEASE",
+	}
+	for i := 1; i < len(mixed); i++ {
+		v1 := mustVersion(t, mixed[i-1], version.WithScheme(version.ReleaseTrainScheme()))
+		v2 := mustVersion(t, mixed[i], version.WithScheme(version.ReleaseTrainScheme()))
+		assert.Equal(t, -1, v1.Compare(v2), "%s %s", v1, v2)
+		assert.Equal(t, 1, v2.Compare(v1), "%s %s", v2, v1)
+	}
+	assert.True(t, mustVersion(t, "Moore-SR1", version.WithScheme(version.ReleaseTrainScheme())).Equal(
+		mustVersion(t, "Moore.SR1", version.WithScheme(version.ReleaseTrainScheme()))))
+
+	v1, err := version.NewVersion("Hoxton.RELEASE", version.WithScheme(version.ReleaseTrainScheme()))
+	require.NoError(t, err)
+	v2, err := version.NewVersion("Hoxton", version.WithScheme(version.ReleaseTrainScheme()))
+	require.NoError(t, err)
+	assert.True(t, v1.Equal(v2))
+	assert.Equal(t, version.StabilityRelease, v1.Stability())
+
+	for s, want := range map[string]version.Stability{
+		"Hoxton.BUILD-SNAPSHOT": version.StabilitySnapshot,
+		"Hoxton.M1":             version.StabilityMilestone,
+		"Hoxton.SR3":            version.StabilityServicePack,
+	} {
+		v, err := version.NewVersion(s, version.WithScheme(version.ReleaseTrainScheme()))
+		require.NoError(t, err)
+		assert.Equal(t, want, v.Stability(), s)
+	}
+
+	// the default scheme ranks BUILD-SNAPSHOT as an unknown qualifier after the release
+	d1, err := version.NewVersion("Hoxton.BUILD-SNAPSHOT")
+	require.NoError(t, err)
+	d2, err := version.NewVersion("Hoxton.RELEASE")
+	require.NoError(t, err)
+	assert.Equal(t, 1, d1.Compare(d2))
+}
+
+func TestDeprecatedQualifiers(t *testing.T) {
+	assert.Equal(t, []string{"alpha", "beta", "milestone", "rc", "snapshot", "", "sp"}, version.Qualifiers)
+	assert.Equal(t, map[string]string{"ga": "", "final": "", "release": "", "cr": "rc"}, version.Aliases)
+	assert.Equal(t, "5", version.ReleaseVersionIndex)
+
+	// they are copies, which do not change the order
+	qualifiers := version.Qualifiers
+	version.Qualifiers = []string{"sp", ""}
+	defer func() { version.Qualifiers = qualifiers }()
+	assert.True(t, mustVersion(t, "1-sp").GreaterThan(mustVersion(t, "1")))
+}
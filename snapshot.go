@@ -0,0 +1,114 @@
+package version
+
+import (
+	"regexp"
+	"strconv"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+const (
+	snapshotQualifier       = "SNAPSHOT"
+	snapshotTimestampLayout = "20060102.150405"
+)
+
+// timestampedSnapshotRegexp matches a deployed snapshot, <base>-<yyyyMMdd.HHmmss>-<build number>
+var timestampedSnapshotRegexp = regexp.MustCompile(`^(.*)-(\d{8}\.\d{6})-(\d+)$`)
+
+type timestampedSnapshot struct {
+	base        string
+	timestamp   time.Time
+	buildNumber int
+}
+
+func (v1 Version) timestampedSnapshot() (timestampedSnapshot, bool) {
+	m := timestampedSnapshotRegexp.FindStringSubmatch(v1.withoutBuildMetadata())
+	if m == nil {
+		return timestampedSnapshot{}, false
+	}
+	t, err := time.Parse(snapshotTimestampLayout, m[2])
+	if err != nil {
+		return timestampedSnapshot{}, false
+	}
+	n, err := strconv.Atoi(m[3])
+	if err != nil {
+		return timestampedSnapshot{}, false
+	}
+	return timestampedSnapshot{
+		base:        m[1],
+		timestamp:   t,
+		buildNumber: n,
+	}, true
+}
+
+// IsTimestampedSnapshot reports whether the version is a deployed snapshot, e.g. "1.2.0-20231010.123456-3".
+func (v1 Version) IsTimestampedSnapshot() bool {
+	_, ok := v1.timestampedSnapshot()
+	return ok
+}
+
+// SnapshotTimestamp returns the deploy time (UTC) of a timestamped snapshot.
+func (v1 Version) SnapshotTimestamp() (time.Time, bool) {
+	s, ok := v1.timestampedSnapshot()
+	return s.timestamp, ok
+}
+
+// SnapshotBuildNumber returns the build number of a timestamped snapshot, e.g. 3 for "1.2.0-20231010.123456-3".
+func (v1 Version) SnapshotBuildNumber() (int, bool) {
+	s, ok := v1.timestampedSnapshot()
+	return s.buildNumber, ok
+}
+
+// BaseVersion returns the "-SNAPSHOT" version of a timestamped snapshot,
+// e.g. "1.2.0-SNAPSHOT" for "1.2.0-20231010.123456-3".
+// Any other version is returned as is.
+func (v1 Version) BaseVersion() Version {
+	s, ok := v1.timestampedSnapshot()
+	if !ok {
+		return v1
+	}
+	base, err := newVersion(s.base+"-"+snapshotQualifier, v1.opts)
+	if err != nil {
+		return v1
+	}
+	return base
+}
+
+// TimestampedSnapshot returns the snapshot of a "-SNAPSHOT" version deployed at t with the build number,
+// e.g. "1.2.0-20231010.123456-3" for "1.2.0-SNAPSHOT".
+func (v1 Version) TimestampedSnapshot(t time.Time, buildNumber int) (Version, error) {
+	value := v1.withoutBuildMetadata()
+	suffix := "-" + snapshotQualifier
+	if !hasSuffixFold(value, suffix) {
+		return Version{}, xerrors.Errorf("not a snapshot version: %s", v1.Value)
+	}
+	if buildNumber < 1 {
+		return Version{}, xerrors.Errorf("invalid build number: %d", buildNumber)
+	}
+
+	base := value[:len(value)-len(suffix)]
+	v := base + "-" + t.UTC().Format(snapshotTimestampLayout) + "-" + strconv.Itoa(buildNumber)
+	return newVersion(v, v1.opts)
+}
+
+// CompareSnapshot compares two timestamped snapshots of the same base version
+// by their timestamps, and then by their build numbers.
+func (v1 Version) CompareSnapshot(v2 Version) (int, error) {
+	s1, ok := v1.timestampedSnapshot()
+	if !ok {
+		return 0, xerrors.Errorf("not a timestamped snapshot: %s", v1.Value)
+	}
+	s2, ok := v2.timestampedSnapshot()
+	if !ok {
+		return 0, xerrors.Errorf("not a timestamped snapshot: %s", v2.Value)
+	}
+	if !v1.BaseVersion().Equal(v2.BaseVersion()) {
+		return 0, xerrors.Errorf("different base versions: %s, %s", v1.Value, v2.Value)
+	}
+
+	if result := s1.timestamp.Compare(s2.timestamp); result != 0 {
+		return result, nil
+	}
+	return compareInt(s1.buildNumber, s2.buildNumber), nil
+}
@@ -0,0 +1,103 @@
+package version_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+func TestVersion_TimestampedSnapshot(t *testing.T) {
+	tests := []struct {
+		v           string
+		want        bool
+		timestamp   time.Time
+		buildNumber int
+		base        string
+	}{
+		{"1.2.0-20231010.123456-3", true, time.Date(2023, 10, 10, 12, 34, 56, 0, time.UTC), 3, "1.2.0-SNAPSHOT"},
+		{"1.2.0-alpha-1-20231010.123456-12", true, time.Date(2023, 10, 10, 12, 34, 56, 0, time.UTC), 12, "1.2.0-alpha-1-SNAPSHOT"},
+		{"1.2.0-SNAPSHOT", false, time.Time{}, 0, "1.2.0-SNAPSHOT"},
+		{"1.2.0", false, time.Time{}, 0, "1.2.0"},
+		{"1.2.0-20231310.123456-3", false, time.Time{}, 0, "1.2.0-20231310.123456-3"},
+		{"1.2.0-20231010.1234-3", false, time.Time{}, 0, "1.2.0-20231010.1234-3"},
+		{"20231010.123456-3", false, time.Time{}, 0, "20231010.123456-3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			v, err := version.NewVersion(tt.v)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, v.IsTimestampedSnapshot())
+
+			ts, ok := v.SnapshotTimestamp()
+			assert.Equal(t, tt.want, ok)
+			assert.True(t, tt.timestamp.Equal(ts))
+
+			n, ok := v.SnapshotBuildNumber()
+			assert.Equal(t, tt.want, ok)
+			assert.Equal(t, tt.buildNumber, n)
+
+			assert.Equal(t, tt.base, v.BaseVersion().String())
+		})
+	}
+}
+
+func TestVersion_ToTimestampedSnapshot(t *testing.T) {
+	ts := time.Date(2023, 10, 10, 21, 34, 56, 0, time.FixedZone("JST", 9*60*60))
+
+	v, err := version.NewVersion("1.2.0-SNAPSHOT")
+	require.NoError(t, err)
+	s, err := v.TimestampedSnapshot(ts, 3)
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.0-20231010.123456-3", s.String())
+	assert.Equal(t, "1.2.0-SNAPSHOT", s.BaseVersion().String())
+
+	lower, err := version.NewVersion("1.2.0-snapshot")
+	require.NoError(t, err)
+	s, err = lower.TimestampedSnapshot(ts, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "1.2.0-20231010.123456-1", s.String())
+
+	_, err = v.TimestampedSnapshot(ts, 0)
+	assert.Error(t, err)
+
+	release, err := version.NewVersion("1.2.0")
+	require.NoError(t, err)
+	_, err = release.TimestampedSnapshot(ts, 1)
+	assert.Error(t, err)
+}
+
+func TestVersion_CompareSnapshot(t *testing.T) {
+	tests := []struct {
+		v1      string
+		v2      string
+		want    int
+		wantErr bool
+	}{
+		{"1.2.0-20231010.123456-3", "1.2.0-20231010.123456-3", 0, false},
+		{"1.2.0-20231010.123456-3", "1.2.0-20231011.000000-1", -1, false},
+		{"1.2.0-20231010.123456-3", "1.2.0-20231010.123456-2", 1, false},
+		{"1.2.0-20231010.123456-3", "1.2-20231010.123457-3", -1, false},
+		{"1.2.0-20231010.123456-3", "1.2.1-20231010.123456-3", 0, true},
+		{"1.2.0-20231010.123456-3", "1.2.0-SNAPSHOT", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v1+" "+tt.v2, func(t *testing.T) {
+			v1, err := version.NewVersion(tt.v1)
+			require.NoError(t, err)
+			v2, err := version.NewVersion(tt.v2)
+			require.NoError(t, err)
+
+			got, err := v1.CompareSnapshot(v2)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
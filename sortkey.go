@@ -0,0 +1,342 @@
+package version
+
+import (
+	"encoding/binary"
+
+	"golang.org/x/xerrors"
+)
+
+// ErrInvalidSortKey is returned by DecodeSortKey for a key which was not made by SortKey.
+var ErrInvalidSortKey = xerrors.New("invalid sort key")
+
+// The tags of the items in a sort key, in the order of the items.
+// An item equal to null (zero, release, an empty list) is placed below or above the end of the list,
+// by the items that follow it.
+const (
+	tagLowString     byte = 0x10 // a qualifier before the release, e.g. "alpha"
+	tagNullStringLow byte = 0x14 // the release qualifier, followed by items less than null
+	tagLowList       byte = 0x20 // a list less than null, e.g. "-alpha"
+	tagNullListLow   byte = 0x24
+	tagNullIntLow    byte = 0x38
+	tagEnd           byte = 0x40 // the end of a list
+	tagNullStringHi  byte = 0x48
+	tagHighString    byte = 0x60 // a qualifier after the release, or an unknown one, e.g. "sp"
+	tagNullListHi    byte = 0x68
+	tagHighList      byte = 0x70 // a list greater than null, e.g. "-1"
+	tagNullIntHi     byte = 0x7c
+	tagInt           byte = 0x80 // a number other than 0
+)
+
+// The first bytes of a key with a CalendarPolicy.
+const (
+	calendarBelow byte = 0x00
+	calendarOther byte = 0x01
+)
+
+// The kinds of qualifiers, written after the name of a qualifier.
+const (
+	kindString      byte = 0x01
+	kindCombination byte = 0x02
+)
+
+// SortKey returns a key of the version, where bytes.Compare of two keys follows Compare of the versions
+// except for the cases below, so that versions can be ordered and range queried in a database.
+// Equal versions have equal keys, e.g. "1.0.0.RELEASE" and "1".
+//
+// Compare is not transitive wherever a list meets a qualifier or a number at the same position,
+// since a list is always greater than a qualifier and less than a number,
+// while each of them is ordered by its own value against the padding of a shorter version,
+// e.g. "1.sp" < "1-alpha" < "1" < "1.sp". No key can follow Compare for all of them.
+// In real versions this happens when "." before a qualifier meets "-" at the same position,
+// e.g. "1.0.0.Alpha1" of JBoss against "1.0-1" or "1.0.sp1".
+// In these cases the key orders an item by how it compares with null first,
+// so "1-alpha" < "1" < "1.sp" and "1.0.alpha" < "1" < "1-1".
+//
+// In ModeMaven, a qualifier ranked as the release followed by a number is equal to null but not to the others,
+// e.g. "1-ga1" == "1" == "1-ga2" but "1-ga1" < "1-ga2", and the key orders "1" < "1-ga1" < "1-ga2".
+//
+// With a CalendarPolicy other than CalendarMixed, the key starts with a byte which ranks the versions based on a date.
+//
+// Keys of versions parsed with different schemes, modes or calendar policies should not be compared.
+func (v1 Version) SortKey() []byte {
+	var b []byte
+	if policy := v1.opts.calendarPolicy; policy != CalendarMixed {
+		b = append(b, choose(v1.belowByCalendar(policy), calendarBelow, calendarOther))
+	}
+	return appendSortKey(b, trimNull(v1.Items))
+}
+
+func appendSortKey(b []byte, items ListItem) []byte {
+	for i, item := range items {
+		sign := item.Compare(nil)
+		if sign == 0 {
+			// the list is trimmed, so one of the following items is not equal to null
+			b = appendNullItem(b, item, nullSign(items[i+1:]))
+			continue
+		}
+
+		switch v := item.(type) {
+		case IntItem, BigIntItem:
+			b = append(b, tagInt)
+			b = appendDigits(b, item)
+		case StringItem:
+			b = appendQualifier(b, sign, v, kindString)
+		case CombinationItem:
+			b = appendQualifier(b, sign, v.stringPart, kindCombination)
+			b = appendDigits(b, v.digitPart)
+		case ListItem:
+			if sign < 0 {
+				b = append(b, tagLowList)
+			} else {
+				b = append(b, tagHighList)
+			}
+			b = appendSortKey(b, v)
+		}
+	}
+	return append(b, tagEnd)
+}
+
+// nullSign returns the result of comparing items with null.
+func nullSign(items ListItem) int {
+	for _, item := range items {
+		if result := item.Compare(nil); result != 0 {
+			return result
+		}
+	}
+	return 0
+}
+
+func appendNullItem(b []byte, item Item, sign int) []byte {
+	low := sign < 0
+	switch v := item.(type) {
+	case IntItem:
+		return append(b, choose(low, tagNullIntLow, tagNullIntHi))
+	case StringItem:
+		return append(b, choose(low, tagNullStringLow, tagNullStringHi), kindString)
+	case CombinationItem:
+		b = append(b, choose(low, tagNullStringLow, tagNullStringHi), kindCombination)
+		return appendDigits(b, v.digitPart)
+	case ListItem:
+		// a list of items equal to null, e.g. [ga1] in ModeMaven
+		b = append(b, choose(low, tagNullListLow, tagNullListHi))
+		return appendSortKey(b, v)
+	}
+	return b
+}
+
+func choose(low bool, lowTag, highTag byte) byte {
+	if low {
+		return lowTag
+	}
+	return highTag
+}
+
+func appendQualifier(b []byte, sign int, item StringItem, kind byte) []byte {
+	b = append(b, choose(sign < 0, tagLowString, tagHighString))
+	rank, known := item.rank()
+	b = appendUint(b, uint64(rank))
+	if !known {
+		// unknown qualifiers are ordered lexically, 0x00 is escaped to keep the order
+		for i := 0; i < len(item.value); i++ {
+			if c := item.value[i]; c == 0x00 {
+				b = append(b, 0x00, 0xff)
+			} else {
+				b = append(b, c)
+			}
+		}
+		b = append(b, 0x00, 0x01)
+	}
+	return append(b, kind)
+}
+
+// appendDigits writes a number as the count of the digits followed by the digits, so that longer numbers are larger.
+func appendDigits(b []byte, item Item) []byte {
+	var digits string
+	switch v := item.(type) {
+	case IntItem:
+		if v != 0 {
+			digits = v.String()
+		}
+	case BigIntItem:
+		digits = string(v)
+	}
+	b = appendUint(b, uint64(len(digits)))
+	return append(b, digits...)
+}
+
+// appendUint writes n as the count of its bytes followed by the big endian bytes, so that larger numbers are larger.
+func appendUint(b []byte, n uint64) []byte {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], n)
+	i := 0
+	for i < len(buf) && buf[i] == 0 {
+		i++
+	}
+	b = append(b, byte(len(buf)-i))
+	return append(b, buf[i:]...)
+}
+
+// DecodeSortKey returns the version of a key made by SortKey.
+// The version is equal to the encoded one, and its Value is the canonical form, e.g. "1" for the key of "1.0.0.RELEASE".
+// opts must have the same scheme, mode and calendar policy as the encoded version.
+func DecodeSortKey(key []byte, opts ...Option) (Version, error) {
+	o := newOptions(opts)
+	d := sortKeyDecoder{key: key, scheme: o.scheme}
+	if o.calendarPolicy != CalendarMixed {
+		c, err := d.byte()
+		if err != nil {
+			return Version{}, err
+		}
+		if c != calendarBelow && c != calendarOther {
+			return Version{}, d.errorf("invalid calendar policy")
+		}
+	}
+	items, err := d.list()
+	if err != nil {
+		return Version{}, err
+	}
+	if d.pos != len(key) {
+		return Version{}, d.errorf("trailing bytes")
+	}
+	return Version{
+		Value: items.String(),
+		Items: items,
+		opts:  o,
+	}.withCalendar(), nil
+}
+
+type sortKeyDecoder struct {
+	key    []byte
+	pos    int
+	scheme *Scheme
+}
+
+func (d *sortKeyDecoder) errorf(msg string) error {
+	return xerrors.Errorf("%s at %d: %w", msg, d.pos, ErrInvalidSortKey)
+}
+
+func (d *sortKeyDecoder) byte() (byte, error) {
+	if d.pos >= len(d.key) {
+		return 0, d.errorf("unexpected end of key")
+	}
+	c := d.key[d.pos]
+	d.pos++
+	return c, nil
+}
+
+func (d *sortKeyDecoder) list() (ListItem, error) {
+	items := ListItem{}
+	for {
+		tag, err := d.byte()
+		if err != nil {
+			return nil, err
+		}
+
+		var item Item
+		switch tag {
+		case tagEnd:
+			return items, nil
+		case tagInt:
+			item, err = d.digits()
+		case tagLowString, tagHighString:
+			item, err = d.qualifier()
+		case tagLowList, tagHighList:
+			item, err = d.list()
+		case tagNullIntLow, tagNullIntHi:
+			item = IntItem(0)
+		case tagNullListLow, tagNullListHi:
+			item, err = d.list()
+		case tagNullStringLow, tagNullStringHi:
+			item, err = d.kind(d.scheme.qualifierItem(""))
+		default:
+			return nil, d.errorf("unknown tag")
+		}
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+}
+
+func (d *sortKeyDecoder) qualifier() (Item, error) {
+	rank, err := d.uint()
+	if err != nil {
+		return nil, err
+	}
+	if rank < uint64(len(d.scheme.qualifiers)) {
+		return d.kind(d.scheme.qualifierItem(d.scheme.qualifiers[rank]))
+	}
+	if rank != uint64(len(d.scheme.qualifiers)) {
+		return nil, d.errorf("unknown qualifier rank")
+	}
+
+	var value []byte
+	for {
+		c, err := d.byte()
+		if err != nil {
+			return nil, err
+		}
+		if c != 0x00 {
+			value = append(value, c)
+			continue
+		}
+		if c, err = d.byte(); err != nil {
+			return nil, err
+		}
+		if c == 0x01 {
+			break
+		}
+		value = append(value, 0x00)
+	}
+	return d.kind(d.scheme.qualifierItem(string(value)))
+}
+
+func (d *sortKeyDecoder) kind(item StringItem) (Item, error) {
+	kind, err := d.byte()
+	if err != nil {
+		return nil, err
+	}
+	switch kind {
+	case kindString:
+		return item, nil
+	case kindCombination:
+		digits, err := d.digits()
+		if err != nil {
+			return nil, err
+		}
+		return CombinationItem{stringPart: item, digitPart: digits}, nil
+	}
+	return nil, d.errorf("unknown qualifier kind")
+}
+
+func (d *sortKeyDecoder) digits() (Item, error) {
+	n, err := d.uint()
+	if err != nil {
+		return nil, err
+	}
+	if n > uint64(len(d.key)-d.pos) {
+		return nil, d.errorf("unexpected end of key")
+	}
+	digits := string(d.key[d.pos : d.pos+int(n)])
+	d.pos += int(n)
+	for i := 0; i < len(digits); i++ {
+		if !isDigitByte(digits[i]) {
+			return nil, d.errorf("invalid number")
+		}
+	}
+	return newIntItem(digits), nil
+}
+
+func (d *sortKeyDecoder) uint() (uint64, error) {
+	n, err := d.byte()
+	if err != nil {
+		return 0, err
+	}
+	if n > 8 || int(n) > len(d.key)-d.pos {
+		return 0, d.errorf("invalid number")
+	}
+	var buf [8]byte
+	copy(buf[8-n:], d.key[d.pos:d.pos+int(n)])
+	d.pos += int(n)
+	return binary.BigEndian.Uint64(buf[:]), nil
+}
@@ -0,0 +1,142 @@
+package version_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+var sortKeyTestVersions = append([]string{
+	"1.sp", "1.0.alpha", "1-0.alpha", "1.foo", "1-rc1", "1.0-rc", "1.0.0-rc-1", "2.0", "1.2.3", "1.2.3-SNAPSHOT",
+	"1.2.3-20220516.081356-12", "1-alpha1", "1.alpha1", "1-ga-1", "1.0.0.ga.0.1", "1.0.0.0.alpha", "1.10", "1.9",
+	"1-foo\x00bar", "1-foo", "1-foo\x00", "100000000000000000000", "1-beta-2", "1-beta-10",
+	"1.0.0.Alpha1", "1.0.sp1", "1.0-1",
+}, keyTestVersions...)
+
+func TestVersion_SortKey(t *testing.T) {
+	for _, mode := range []version.Mode{version.ModeSpec, version.ModeMaven} {
+		var vs []version.Version
+		for _, s := range sortKeyTestVersions {
+			v, err := version.NewVersion(s, version.WithMode(mode))
+			require.NoError(t, err)
+			vs = append(vs, v)
+		}
+
+		for _, v1 := range vs {
+			for _, v2 := range vs {
+				want := v1.Compare(v2)
+				got := bytes.Compare(v1.SortKey(), v2.SortKey())
+				if got == want {
+					continue
+				}
+				// no key can follow Compare where it is not transitive
+				assert.True(t, inCycle(vs, v1, v2), "mode %d: %s %s: Compare %d, SortKey %d", mode, v1, v2, want, got)
+			}
+		}
+	}
+}
+
+// inCycle returns true if Compare is not transitive for v1, v2 and another version,
+// e.g. v1 < v2 < v3 < v1, or v1 == v2 but v1 < v3 < v2.
+func inCycle(vs []version.Version, v1, v2 version.Version) bool {
+	for _, v3 := range vs {
+		t := []version.Version{v1, v2, v3}
+		for _, p := range [][3]int{{0, 1, 2}, {0, 2, 1}, {1, 0, 2}, {1, 2, 0}, {2, 0, 1}, {2, 1, 0}} {
+			a, b, c := t[p[0]], t[p[1]], t[p[2]]
+			ab, bc, ac := a.Compare(b), b.Compare(c), a.Compare(c)
+			if ab > 0 || bc > 0 {
+				continue
+			}
+			if ab == 0 && bc == 0 && ac != 0 || (ab < 0 || bc < 0) && ac >= 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func TestVersion_SortKeyOrder(t *testing.T) {
+	// sorted by Compare
+	versions := []string{
+		"1-alpha-1", "1-alpha-2", "1-beta", "1-rc", "1-snapshot", "1", "1-sp", "1-foo", "1-1", "1.0.1", "1.1-snapshot",
+		"1.1", "1.2", "1.10", "2", "99999999999999999999",
+	}
+	var prev []byte
+	for _, s := range versions {
+		v, err := version.NewVersion(s)
+		require.NoError(t, err)
+		key := v.SortKey()
+		assert.Equal(t, 1, bytes.Compare(key, prev), s)
+		prev = key
+	}
+
+	v1, err := version.NewVersion("1.0.0.RELEASE")
+	require.NoError(t, err)
+	v2, err := version.NewVersion("1-ga")
+	require.NoError(t, err)
+	assert.Equal(t, v1.SortKey(), v2.SortKey())
+}
+
+func TestVersion_SortKeyMixedSeparators(t *testing.T) {
+	// "." before a qualifier meets "-", where Compare is not transitive
+	alpha := mustVersion(t, "1.0.0.Alpha1")
+	sp := mustVersion(t, "1.0.sp1")
+	build := mustVersion(t, "1.0-1")
+	release := mustVersion(t, "1.0")
+
+	assert.Equal(t, 1, alpha.Compare(build))
+	assert.Equal(t, 1, alpha.Compare(sp))
+	// the key orders them by how they compare with "1.0"
+	assert.Equal(t, -1, bytes.Compare(alpha.SortKey(), release.SortKey()))
+	assert.Equal(t, -1, bytes.Compare(release.SortKey(), build.SortKey()))
+	assert.Equal(t, -1, bytes.Compare(release.SortKey(), sp.SortKey()))
+}
+
+func TestVersion_SortKeyCombination(t *testing.T) {
+	opt := version.WithMode(version.ModeMaven)
+	// sorted by the key, "1" is equal to all of them by Compare
+	versions := []string{"1", "1.0-GA1", "1.0-GA2", "1.0-Final3"}
+	var prev []byte
+	for _, s := range versions {
+		key := mustVersion(t, s, opt).SortKey()
+		assert.Equal(t, 1, bytes.Compare(key, prev), s)
+		prev = key
+	}
+
+	v1 := mustVersion(t, "1.0-GA1", opt)
+	v2 := mustVersion(t, "1.0-GA2", opt)
+	assert.Equal(t, v1.Compare(v2), bytes.Compare(v1.SortKey(), v2.SortKey()))
+	got, err := version.DecodeSortKey(v1.SortKey(), opt)
+	require.NoError(t, err)
+	assert.Equal(t, -1, got.Compare(v2))
+	assert.Equal(t, v1.SortKey(), got.SortKey())
+}
+
+func TestDecodeSortKey(t *testing.T) {
+	for _, mode := range []version.Mode{version.ModeSpec, version.ModeMaven} {
+		for _, s := range sortKeyTestVersions {
+			v, err := version.NewVersion(s, version.WithMode(mode))
+			require.NoError(t, err)
+
+			got, err := version.DecodeSortKey(v.SortKey(), version.WithMode(mode))
+			require.NoError(t, err)
+			assert.Equal(t, 0, got.Compare(v), "mode %d: %s", mode, s)
+			assert.Equal(t, v.SortKey(), got.SortKey(), "mode %d: %s", mode, s)
+		}
+	}
+
+	v, err := version.NewVersion("1.0.0.RELEASE")
+	require.NoError(t, err)
+	got, err := version.DecodeSortKey(v.SortKey())
+	require.NoError(t, err)
+	assert.Equal(t, "1", got.String())
+
+	for _, key := range [][]byte{nil, {0x80}, {0x80, 0x01}, {0x40, 0x40}, {0xff}, {0x10, 0x01, 0x09, 0x01, 0x40}} {
+		_, err := version.DecodeSortKey(key)
+		assert.ErrorIs(t, err, version.ErrInvalidSortKey, "%x", key)
+	}
+}
@@ -0,0 +1,114 @@
+package version
+
+// Stability is the maturity of a version, derived from its qualifiers.
+type Stability int
+
+const (
+	// StabilityPrerelease is a pre-release qualifier of a custom Scheme which has no own Stability.
+	StabilityPrerelease Stability = iota
+	StabilityAlpha
+	StabilityBeta
+	StabilityMilestone
+	StabilityReleaseCandidate
+	StabilitySnapshot
+	StabilityRelease
+	StabilityServicePack
+)
+
+var (
+	stabilityNames = map[Stability]string{
+		StabilityPrerelease:       "prerelease",
+		StabilityAlpha:            "alpha",
+		StabilityBeta:             "beta",
+		StabilityMilestone:        "milestone",
+		StabilityReleaseCandidate: "rc",
+		StabilitySnapshot:         "snapshot",
+		StabilityRelease:          "release",
+		StabilityServicePack:      "sp",
+	}
+	qualifierStabilities = map[string]Stability{
+		"alpha":     StabilityAlpha,
+		"beta":      StabilityBeta,
+		"milestone": StabilityMilestone,
+		"rc":        StabilityReleaseCandidate,
+	}
+)
+
+func (s Stability) String() string {
+	return stabilityNames[s]
+}
+
+// Stability classifies the version by all of its qualifiers, including the nested ones:
+//   - a snapshot qualifier anywhere, or a timestamped snapshot, is StabilitySnapshot. e.g. "1-alpha-1-SNAPSHOT"
+//   - otherwise the lowest pre-release qualifier wins. e.g. "1-rc1-sp2" is StabilityReleaseCandidate
+//   - otherwise a known qualifier after the release is StabilityServicePack. e.g. "1-sp2"
+//   - otherwise it is StabilityRelease. Unknown qualifiers are ordered after the release, e.g. "31.1-jre".
+func (v1 Version) Stability() Stability {
+	if v1.IsTimestampedSnapshot() {
+		return StabilitySnapshot
+	}
+
+	var snapshot, servicePack bool
+	var lowest *StringItem
+	var lowestRank int
+	walkQualifiers(v1.Items, func(q StringItem) {
+		rank, known := q.rank()
+		release := q.getScheme().release
+		switch {
+		case q.value == "snapshot":
+			snapshot = true
+		case !known:
+		case rank < release:
+			if lowest == nil || rank < lowestRank {
+				lowest = &q
+				lowestRank = rank
+			}
+		case rank > release:
+			servicePack = true
+		}
+	})
+
+	switch {
+	case snapshot:
+		return StabilitySnapshot
+	case lowest != nil:
+		if s, ok := qualifierStabilities[lowest.value]; ok {
+			return s
+		}
+		return StabilityPrerelease
+	case servicePack:
+		return StabilityServicePack
+	}
+	return StabilityRelease
+}
+
+// IsRelease reports whether the version is a release or a service pack.
+func (v1 Version) IsRelease() bool {
+	s := v1.Stability()
+	return s == StabilityRelease || s == StabilityServicePack
+}
+
+// IsPrerelease reports whether the version is an alpha, beta, milestone or release candidate.
+// Snapshots are reported by IsSnapshot.
+func (v1 Version) IsPrerelease() bool {
+	return v1.Stability() < StabilitySnapshot
+}
+
+// IsSnapshot reports whether the version is a snapshot, e.g. "1.0-SNAPSHOT" or "1.0-20231010.123456-3".
+func (v1 Version) IsSnapshot() bool {
+	return v1.Stability() == StabilitySnapshot
+}
+
+// walkQualifiers calls fn for each qualifier in items and in the nested lists.
+func walkQualifiers(items ListItem, fn func(StringItem)) {
+	for _, item := range items {
+		switch v := item.(type) {
+		case StringItem:
+			fn(v)
+		case CombinationItem:
+			fn(v.stringPart)
+		case ListItem:
+			walkQualifiers(v, fn)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+func TestVersion_Stability(t *testing.T) {
+	tests := []struct {
+		v    string
+		want version.Stability
+	}{
+		{"1.0", version.StabilityRelease},
+		{"1.0.0.RELEASE", version.StabilityRelease},
+		{"1.0-ga", version.StabilityRelease},
+		{"31.1-jre", version.StabilityRelease},
+		{"1.0-alpha", version.StabilityAlpha},
+		{"1.0-a1", version.StabilityAlpha},
+		{"1.0-beta-2", version.StabilityBeta},
+		{"1.0b2", version.StabilityBeta},
+		{"1.0-M1", version.StabilityMilestone},
+		{"3.0.0.M1", version.StabilityMilestone},
+		{"1.0-rc1", version.StabilityReleaseCandidate},
+		{"1.0-CR2", version.StabilityReleaseCandidate},
+		{"1.0-SNAPSHOT", version.StabilitySnapshot},
+		{"1.0-alpha-1-SNAPSHOT", version.StabilitySnapshot},
+		{"1.0-20231010.123456-3", version.StabilitySnapshot},
+		{"1.0-sp", version.StabilityServicePack},
+		{"1.0.SP1", version.StabilityServicePack},
+		{"1-rc1-sp2", version.StabilityReleaseCandidate},
+		{"1-sp1-beta2", version.StabilityBeta},
+		{"1-beta1-alpha2", version.StabilityAlpha},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			v, err := version.NewVersion(tt.v)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, v.Stability(), v.Stability().String())
+
+			m, err := version.NewVersion(tt.v, version.WithMode(version.ModeMaven))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, m.Stability(), "maven mode")
+		})
+	}
+}
+
+func TestVersion_IsRelease(t *testing.T) {
+	tests := []struct {
+		v          string
+		release    bool
+		prerelease bool
+		snapshot   bool
+	}{
+		{"1.0", true, false, false},
+		{"1.0-sp1", true, false, false},
+		{"1.0-rc1", false, true, false},
+		{"1.0-SNAPSHOT", false, false, true},
+		{"1.0-rc1-SNAPSHOT", false, false, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			v, err := version.NewVersion(tt.v)
+			require.NoError(t, err)
+			assert.Equal(t, tt.release, v.IsRelease())
+			assert.Equal(t, tt.prerelease, v.IsPrerelease())
+			assert.Equal(t, tt.snapshot, v.IsSnapshot())
+		})
+	}
+}
+
+func TestVersion_StabilityScheme(t *testing.T) {
+	scheme, err := version.NewScheme([]string{"dev", "alpha", "", "hotfix"}, nil, nil)
+	require.NoError(t, err)
+
+	tests := []struct {
+		v    string
+		want version.Stability
+	}{
+		{"1.0-dev", version.StabilityPrerelease},
+		{"1.0-dev-alpha", version.StabilityPrerelease},
+		{"1.0-alpha", version.StabilityAlpha},
+		{"1.0-hotfix", version.StabilityServicePack},
+		{"1.0-sp", version.StabilityRelease},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			v, err := version.NewVersion(tt.v, version.WithScheme(scheme))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, v.Stability())
+		})
+	}
+}
@@ -0,0 +1,46 @@
+package version
+
+// Truncate returns the version of the first n segments of the normalized items, e.g. "1.2" for "1.2.3-SNAPSHOT" and 2.
+// Each number, qualifier and combination is a segment, counted from the left through the nested lists,
+// and a nested list is kept as far as it holds the kept segments, e.g. "1.2.3-beta" for "1.2.3-beta-4" and 4.
+// Zeros and release qualifiers removed by normalization are not counted,
+// e.g. "1.0-1" is normalized to "1-1", whose first 2 segments are "1-1".
+// The kept segments are normalized again, e.g. "1" for "1.0.1" and 2, as NewVersion("1.0") is.
+// The Value of the returned version is its canonical form, and n <= 0 returns an empty version, which is equal to "0".
+func (v1 Version) Truncate(n int) Version {
+	items, _ := truncateItems(v1.Items, n, v1.opts.mode)
+	return Version{
+		Value: items.String(),
+		Items: items,
+		opts:  v1.opts,
+	}.withCalendar()
+}
+
+func truncateItems(items ListItem, n int, mode Mode) (ListItem, int) {
+	ret := ListItem{}
+	for _, item := range items {
+		if n <= 0 {
+			break
+		}
+		if l, ok := item.(ListItem); ok {
+			var sub ListItem
+			sub, n = truncateItems(l, n, mode)
+			if len(sub) > 0 {
+				ret = append(ret, sub)
+			}
+			continue
+		}
+		ret = append(ret, item)
+		n--
+	}
+	if mode == ModeMaven {
+		return ret.normalizeMaven(), n
+	}
+	return ret.normalize(), n
+}
+
+// CompareN compares the first n segments of the versions, ignoring the rest, as Truncate does.
+// e.g. "1.2.3" and "1.2.9-SNAPSHOT" are equal for 2, and "11.0.2" is greater than or equal to "11" for 1.
+func (v1 Version) CompareN(v2 Version, n int) int {
+	return v1.Truncate(n).Compare(v2.Truncate(n))
+}
@@ -0,0 +1,75 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+func TestVersion_Truncate(t *testing.T) {
+	tests := []struct {
+		v    string
+		opts []version.Option
+		n    int
+		want string
+	}{
+		{v: "1.2.3-SNAPSHOT", n: 2, want: "1.2"},
+		{v: "1.2.3-SNAPSHOT", n: 3, want: "1.2.3"},
+		{v: "1.2.3-SNAPSHOT", n: 4, want: "1.2.3-snapshot"},
+		{v: "1.2.3-SNAPSHOT", n: 10, want: "1.2.3-snapshot"},
+		{v: "1.2.3-beta-4", n: 4, want: "1.2.3-beta"},
+		{v: "1.2.3-beta-4", n: 5, want: "1.2.3-beta-4"},
+		{v: "1.0-1", n: 2, want: "1-1"},
+		{v: "1.0.1", n: 2, want: "1"},
+		{v: "1.0.1", n: 2, opts: []version.Option{version.WithMode(version.ModeMaven)}, want: "1"},
+		{v: "1.2.0.1-rc", n: 3, want: "1.2"},
+		{v: "1.2-rc1", n: 3, want: "1.2-rc"},
+		{v: "1.2-rc1", n: 3, opts: []version.Option{version.WithMode(version.ModeMaven)}, want: "1.2-rc1"},
+		{v: "1.2.3", n: 0, want: ""},
+		{v: "1.2.3", n: -1, want: ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v, func(t *testing.T) {
+			v, err := version.NewVersion(tt.v, tt.opts...)
+			require.NoError(t, err)
+			got := v.Truncate(tt.n)
+			assert.Equal(t, tt.want, got.String())
+			assert.Equal(t, tt.want, got.Canonical())
+
+			want, err := version.NewVersion(tt.want, tt.opts...)
+			require.NoError(t, err)
+			assert.True(t, got.Equal(want))
+		})
+	}
+}
+
+func TestVersion_CompareN(t *testing.T) {
+	tests := []struct {
+		v1   string
+		v2   string
+		n    int
+		want int
+	}{
+		{"1.2.3", "1.2.9-SNAPSHOT", 2, 0},
+		{"1.2.3", "1.3.0", 2, -1},
+		{"11.0.2", "11", 1, 0},
+		{"17", "11", 1, 1},
+		{"11-ea", "11", 1, 0},
+		{"11-rc", "11", 2, -1},
+		{"1.2.3", "1.2.3-SNAPSHOT", 3, 0},
+		{"1.2.3", "1.2.3-SNAPSHOT", 4, 1},
+		{"1.2", "2.0", 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.v1+" "+tt.v2, func(t *testing.T) {
+			v1, err := version.NewVersion(tt.v1)
+			require.NoError(t, err)
+			v2, err := version.NewVersion(tt.v2)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, v1.CompareN(v2, tt.n))
+		})
+	}
+}
@@ -0,0 +1,88 @@
+package version_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+// versions with non-ASCII characters found in the wild
+var unicodeTestVersions = []struct {
+	v         string
+	canonical string
+	// compared with "1"
+	want int
+}{
+	{v: "1.0-α", canonical: "1-α", want: 1},
+	{v: "1.0-β2", canonical: "1-β-2", want: 1},
+	{v: "1.0-Β2", canonical: "1-Β-2", want: 1},              // capital beta is not lowercased
+	{v: "１.２.３", canonical: "１.２.３", want: -1},              // full-width digits are not numbers
+	{v: "١.٢", canonical: "١.٢", want: -1},                  // arabic-indic digits are not numbers
+	{v: "1.0-FİNAL", canonical: "1-fİnal", want: 1},         // dotted capital I is not folded into "i"
+	{v: "1.0-fınal", canonical: "1-fınal", want: 1},         // dotless small i is not "i"
+	{v: "1.0-rс", canonical: "1-rс", want: 1},               // cyrillic es is not "c"
+	{v: "1.0-ＳＮＡＰＳＨＯＴ", canonical: "1-ＳＮＡＰＳＨＯＴ", want: 1},   // full-width letters are not lowercased
+	{v: "\ufeff1.0.0", canonical: "\ufeff-1", want: -1},     // byte order mark
+	{v: "1.0\u00a0RC1", canonical: "1-\u00a0rc-1", want: 1}, // a no-break space is not a separator
+	{v: "1.0-é", canonical: "1-é", want: 1},               // not normalized to "é"
+	{v: "1.0-正式版", canonical: "1-正式版", want: 1},
+	{v: "1.0-\xff", canonical: "1-\xff", want: 1}, // invalid UTF-8 is kept as is
+}
+
+func TestNewVersion_Unicode(t *testing.T) {
+	one, err := version.NewVersion("1")
+	require.NoError(t, err)
+
+	for _, tt := range unicodeTestVersions {
+		t.Run(tt.v, func(t *testing.T) {
+			v, err := version.NewVersion(tt.v)
+			require.NoError(t, err)
+			assert.Equal(t, tt.canonical, v.Canonical())
+			assert.Equal(t, tt.want, v.Compare(one))
+
+			_, err = version.NewVersion(tt.v, version.WithStrict())
+			assert.ErrorIs(t, err, version.ErrInvalidCharacter)
+		})
+	}
+}
+
+func TestNewVersion_UnicodeCase(t *testing.T) {
+	tests := []struct {
+		v1   string
+		v2   string
+		want int
+	}{
+		{"1.0-RC1", "1.0-rc1", 0},
+		{"1.0-É", "1.0-é", -1},
+		{"1.0-é", "1.0-é", 1},
+		{"1.0-α", "1.0-β", -1},
+		{"1.0-FİNAL", "1.0-FINAL", 1},
+		{"１", "a", 1},
+	}
+	for _, mode := range []version.Mode{version.ModeSpec, version.ModeMaven} {
+		for _, tt := range tests {
+			v1, err := version.NewVersion(tt.v1, version.WithMode(mode))
+			require.NoError(t, err)
+			v2, err := version.NewVersion(tt.v2, version.WithMode(mode))
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, v1.Compare(v2), "mode %d: %s %s", mode, tt.v1, tt.v2)
+		}
+	}
+}
+
+func TestNewVersion_UnicodeKeys(t *testing.T) {
+	for _, mode := range []version.Mode{version.ModeSpec, version.ModeMaven} {
+		for _, tt := range unicodeTestVersions {
+			v, err := version.NewVersion(tt.v, version.WithMode(mode))
+			require.NoError(t, err)
+
+			d, err := version.DecodeSortKey(v.SortKey(), version.WithMode(mode))
+			require.NoError(t, err)
+			assert.Equal(t, 0, d.Compare(v), "mode %d: %s", mode, tt.v)
+			assert.Equal(t, v.Key(), d.Key(), "mode %d: %s", mode, tt.v)
+		}
+	}
+}
@@ -6,29 +6,86 @@ import (
 	"strings"
 )
 
-var (
-	Qualifiers          = []string{"alpha", "beta", "milestone", "rc", "snapshot", "", "sp"}
-	Aliases             = map[string]string{"ga": "", "final": "", "release": "", "cr": "rc"}
-	ReleaseVersionIndex = fmt.Sprint(indexOf("", Qualifiers))
-)
-
 type Version struct {
 	Value string
+	// Items are the normalized items, which are shared by the copies of the version and must not be modified.
+	//
+	// Deprecated: Use Root, which returns a read-only view of the items.
 	Items ListItem
+
+	// opts are kept to parse versions derived from this one.
+	opts options
+	// calendar is the CalendarKind plus one if it is detected when parsed with a CalendarPolicy, or 0 otherwise.
+	calendar CalendarKind
 }
 
-func NewVersion(v string) (Version, error) {
+// NewVersion parses v as a maven version.
+// By default any string is accepted; use WithStrict to reject malformed input.
+//
+// Characters are classified by ASCII only, regardless of the locale:
+// "0" to "9" are the only digits, "." and "-" the only separators, and "A" to "Z" the only letters lowercased.
+// Any other character, e.g. "１" (full-width one) or "İ" (dotted capital I), is a part of a qualifier
+// and compared byte by byte, so "1-É" and "1-é" are different unknown qualifiers.
+// Maven uses Character.isDigit and lowercases with the English locale instead, so it differs for non-ASCII characters.
+func NewVersion(v string, opts ...Option) (Version, error) {
+	return newVersion(v, newOptions(opts))
+}
+
+func newVersion(v string, o options) (Version, error) {
+	if o.scheme == nil {
+		// the options of a Version literal, which is not parsed
+		o.scheme = defaultScheme
+	}
+
+	base := v
+	if o.buildMetadata {
+		if i := strings.IndexByte(v, '+'); i >= 0 {
+			base = v[:i]
+		}
+	}
+
+	if o.strict {
+		if err := validateVersion(base); err != nil {
+			return Version{}, err
+		}
+		if len(base) < len(v) {
+			if err := validateBuildMetadata(v, len(base)+1); err != nil {
+				return Version{}, err
+			}
+		}
+	}
+	items := parseVersion(base, o.scheme)
+	if o.mode == ModeMaven {
+		items = parseVersionMaven(base, o.scheme)
+	}
 	return Version{
 		Value: v,
-		Items: parseVersion(v),
-	}, nil
+		Items: items,
+		opts:  o,
+	}.withCalendar(), nil
+}
+
+// Clone returns the version with its own copy of Items, which can be modified without affecting the other versions,
+// e.g. the ones shared by a Parser.
+func (v1 Version) Clone() Version {
+	v1.Items = v1.Items.clone()
+	return v1
 }
 
 func (v1 Version) String() string {
 	return v1.Value
 }
 
+// Canonical returns the normalized form of the version, rendered as maven's ComparableVersion.getCanonical() does.
+// e.g. "1.0.0.RELEASE", "1-ga" and "1" are all rendered as "1".
+func (v1 Version) Canonical() string {
+	return v1.Items.String()
+}
+
 func (v1 Version) Compare(v2 Version) int {
+	if result := compareCalendar(v1, v2); result != 0 {
+		return result
+	}
 	return v1.Items.Compare(v2.Items)
 }
 
@@ -57,12 +114,24 @@ type Item interface {
 	isNull() bool
 }
 
-func parseItem(isDigit bool, item string) Item {
+func parseItem(isDigit bool, item string, scheme *Scheme) Item {
 	if isDigit {
-		i, _ := strconv.Atoi(item)
-		return IntItem(i)
+		return newIntItem(item)
+	}
+	return scheme.stringItem(item, false)
+}
+
+// newIntItem returns an IntItem, or a BigIntItem if the number does not fit in an int.
+func newIntItem(digits string) Item {
+	digits = strings.TrimLeft(digits, "0")
+	if digits == "" {
+		return IntItem(0)
 	}
-	return newStringItem(item, false)
+	i, err := strconv.Atoi(digits)
+	if err != nil {
+		return BigIntItem(digits)
+	}
+	return IntItem(i)
 }
 
 type IntItem int
@@ -78,10 +147,14 @@ func (item1 IntItem) Compare(item2 Item) int {
 	switch t := item2.(type) {
 	case IntItem:
 		return compareInt(int(item1), int(t))
+	case BigIntItem:
+		return -1 // an IntItem is always smaller than a BigIntItem
 	case StringItem:
 		return 1 // 1.1 > 1-sp
 	case ListItem:
 		return 1 // 1.1 > 1-1
+	case CombinationItem:
+		return 1 // 1.1 > 1-a1
 	}
 	return 0
 }
@@ -90,63 +163,115 @@ func (item1 IntItem) isNull() bool {
 	return item1 == 0
 }
 
-type StringItem string
+func (item1 IntItem) String() string {
+	return strconv.Itoa(int(item1))
+}
 
-func newStringItem(value string, followedByDigit bool) StringItem {
-	if followedByDigit {
-		switch value {
-		case "a":
-			return "alpha"
-		case "b":
-			return "beta"
-		case "m":
-			return "milestone"
-		}
+// BigIntItem is a number too large for IntItem.
+// It holds the decimal digits without leading zeros.
+type BigIntItem string
+
+func (item1 BigIntItem) Compare(item2 Item) int {
+	if item2 == nil {
+		return 1
 	}
 
-	v, ok := Aliases[value]
-	if ok {
-		return StringItem(v)
+	switch t := item2.(type) {
+	case IntItem:
+		return 1
+	case BigIntItem:
+		if len(item1) != len(t) {
+			return compareInt(len(item1), len(t))
+		}
+		return strings.Compare(string(item1), string(t))
+	case StringItem:
+		return 1
+	case ListItem:
+		return 1
+	case CombinationItem:
+		return 1
 	}
-	return StringItem(value)
+	return 0
+}
+
+func (item1 BigIntItem) isNull() bool {
+	return false
+}
+
+func (item1 BigIntItem) String() string {
+	return string(item1)
+}
+
+// StringItem is a qualifier, ordered by the Scheme it was parsed with.
+type StringItem struct {
+	value  string
+	scheme *Scheme
+	// index is the rank in the scheme plus one, -1 for an unknown qualifier, or 0 if it is not computed yet.
+	index int
 }
 
 func (item1 StringItem) Compare(item2 Item) int {
 	if item2 == nil {
 		// 1-rc < 1, 1-ga > 1
-		return strings.Compare(item1.comparableQualifier(), ReleaseVersionIndex)
+		rank, _ := item1.rank()
+		return compareInt(rank, item1.getScheme().release)
 	}
 
 	switch v := item2.(type) {
-	case IntItem:
+	case IntItem, BigIntItem:
 		return -1
 	case StringItem:
-		return strings.Compare(item1.comparableQualifier(), v.comparableQualifier())
+		rank1, known := item1.rank()
+		rank2, _ := v.rank()
+		if rank1 != rank2 {
+			return compareInt(rank1, rank2)
+		}
+		if known {
+			return 0
+		}
+		// unknown qualifiers are ordered lexically
+		return strings.Compare(item1.value, v.value)
 	case ListItem:
 		return -1 // 1.any < 1-1
+	case CombinationItem:
+		if result := item1.Compare(v.stringPart); result != 0 {
+			return result
+		}
+		return -1 // X < X1
 	}
 	return 0
 }
 
 func (item1 StringItem) isNull() bool {
-	return item1 == ""
+	return item1.value == ""
+}
+
+func (item1 StringItem) String() string {
+	return item1.value
 }
 
-func (item1 StringItem) comparableQualifier() string {
-	index := indexOf(string(item1), Qualifiers)
-	if index == -1 {
-		return fmt.Sprintf("%d-%s", len(Qualifiers), item1)
+func (item1 StringItem) getScheme() *Scheme {
+	if item1.scheme == nil {
+		return defaultScheme
 	}
-	return fmt.Sprint(index)
+	return item1.scheme
 }
 
-func indexOf(s string, sa []string) int {
-	for i, q := range sa {
-		if q == s {
-			return i
-		}
+// rank returns the index of the qualifier in the scheme,
+// or the number of the known qualifiers if it is unknown.
+func (item1 StringItem) rank() (int, bool) {
+	switch {
+	case item1.index > 0:
+		return item1.index - 1, true
+	case item1.index < 0:
+		return len(item1.getScheme().qualifiers), false
 	}
-	return -1
+
+	scheme := item1.getScheme()
+	if rank, ok := scheme.ranks[item1.value]; ok {
+		return rank, true
+	}
+	return len(scheme.qualifiers), false
 }
 
 type ListItem []Item
@@ -166,14 +291,21 @@ func (items1 ListItem) Compare(item2 Item) int {
 	}
 
 	switch v := item2.(type) {
-	case IntItem:
+	case IntItem, BigIntItem:
 		return -1 // 1-1 < 1.0.x
 	case StringItem:
 		return 1 // 1-1 > 1-sp
+	case CombinationItem:
+		return 1 // 1-1 > 1-a1
 	case ListItem:
-		iter := zip(items1, v)
-		for tuple := iter(); tuple != nil; tuple = iter() {
-			l, r := tuple[0], tuple[1]
+		for i := 0; i < len(items1) || i < len(v); i++ {
+			var l, r Item
+			if i < len(items1) {
+				l = items1[i]
+			}
+			if i < len(v) {
+				r = v[i]
+			}
 
 			var result int
 			if l == nil {
@@ -195,6 +327,26 @@ func (items1 ListItem) isNull() bool {
 	return len(items1) == 0
 }
 
+// String joins the items with "." and nested lists with "-".
+// Empty lists are skipped as maven's normalize drops them, so "1-ga" ([1, []]) is rendered as "1".
+func (items1 ListItem) String() string {
+	var b strings.Builder
+	for _, item := range items1 {
+		if l, ok := item.(ListItem); ok && len(l) == 0 {
+			continue
+		}
+		if b.Len() > 0 {
+			if _, ok := item.(ListItem); ok {
+				b.WriteByte('-')
+			} else {
+				b.WriteByte('.')
+			}
+		}
+		b.WriteString(fmt.Sprint(item))
+	}
+	return b.String()
+}
+
 func (items1 ListItem) normalize() ListItem {
 	ret := items1
 	for i := len(items1) - 1; i >= 0; i-- {
@@ -208,89 +360,181 @@ func (items1 ListItem) normalize() ListItem {
 	return ret
 }
 
-func zip(a, b ListItem) func() []Item {
-	i := 0
-	return func() []Item {
-		var item1, item2 Item
-		if i < len(a) {
-			item1 = a[i]
-		}
-		if i < len(b) {
-			item2 = b[i]
+// clone returns a copy of the list and the nested lists, placed in a single backing array.
+// The other items are immutable, so they are shared.
+func (items1 ListItem) clone() ListItem {
+	if items1 == nil {
+		return nil
+	}
+	ret, _ := items1.cloneInto(make([]Item, 0, items1.size()))
+	return ret
+}
+
+// size returns the number of the items in the list and the nested lists.
+func (items1 ListItem) size() int {
+	n := len(items1)
+	for _, item := range items1 {
+		if l, ok := item.(ListItem); ok {
+			n += l.size()
 		}
-		if item1 == nil && item2 == nil {
-			return nil
+	}
+	return n
+}
+
+func (items1 ListItem) cloneInto(buf []Item) (ListItem, []Item) {
+	start := len(buf)
+	buf = append(buf, items1...)
+	ret := ListItem(buf[start:len(buf):len(buf)])
+	for i, item := range ret {
+		if l, ok := item.(ListItem); ok {
+			ret[i], buf = l.cloneInto(buf)
 		}
-		i++
-		return []Item{item1, item2}
 	}
+	return ret, buf
 }
 
-// parseVersion is normalize version string.
-func parseVersion(v string) ListItem {
-	stack := new(ListItemStack)
-	var list ListItem
+// parseVersion parses v into the normalized items.
+// It scans the bytes of v once, and places all the nested lists in a single backing array,
+// so that a version costs one allocation plus one for each item that needs boxing,
+// i.e. numbers of 256 or more and unknown qualifiers.
+func parseVersion(v string, scheme *Scheme) ListItem {
+	var tokenBuf [16]token
+	tokens := tokenBuf[:0]
+	lists := 0
 
 	isDigit := false
 	startIndex := 0
-	str := strings.ToLower(v)
-	sa := strings.Split(str, "")
-	for i, c := range sa {
-		if c == "." {
-			if i == startIndex {
-				list = append(list, IntItem(0))
-			} else {
-				list = append(list, parseItem(isDigit, str[startIndex:i]))
-			}
-			startIndex = i + 1
-		} else if c == "-" {
+	for i := 0; i < len(v); i++ {
+		c := v[i]
+		if c == '.' || c == '-' {
+			t := token{start: startIndex, end: i, kind: tokenKind(isDigit), closes: c == '-' && !scheme.dashAsDot}
 			if i == startIndex {
-				list = append(list, IntItem(0))
-			} else {
-				list = append(list, parseItem(isDigit, str[startIndex:i]))
+				t.kind = tokenZero
 			}
+			tokens = append(tokens, t)
 			startIndex = i + 1
-
-			stack.Push(list)
-			list = ListItem{}
-
-		} else if _, err := strconv.Atoi(c); err == nil {
+		} else if isDigitByte(c) {
 			if !isDigit && i > startIndex {
-				list = append(list, newStringItem(str[startIndex:i], true))
+				tokens = append(tokens, token{start: startIndex, end: i, kind: tokenShorthand, closes: true})
 				startIndex = i
-
-				stack.Push(list)
-				list = ListItem{}
 			}
-
 			isDigit = true
 		} else {
 			if isDigit && i > startIndex {
-				list = append(list, parseItem(true, str[startIndex:i]))
+				tokens = append(tokens, token{start: startIndex, end: i, kind: tokenDigits, closes: true})
 				startIndex = i
-
-				stack.Push(list)
-				list = ListItem{}
 			}
 			isDigit = false
 		}
 	}
+
 	if len(v) > startIndex {
-		list = append(list, parseItem(isDigit, str[startIndex:]))
-		stack.Push(list)
+		tokens = append(tokens, token{start: startIndex, end: len(v), kind: tokenKind(isDigit), closes: true})
+	} else {
+		// the items after the last list are dropped, "1-2." => [1], unless there is no list, "1." => [1]
+		last := len(tokens) - 1
+		for last >= 0 && !tokens[last].closes {
+			last--
+		}
+		if last >= 0 {
+			tokens = tokens[:last+1]
+		} else if len(tokens) > 0 {
+			tokens[len(tokens)-1].closes = true
+		}
+	}
+	for _, t := range tokens {
+		if t.closes {
+			lists++
+		}
+	}
+	if lists == 0 {
+		return nil
 	}
 
-	if stack.IsEmpty() {
-		stack.Push(list)
+	// each list is followed by a slot for its sub list
+	buf := make([]Item, len(tokens)+lists)
+	var startBuf [8]int
+	starts := startBuf[:0]
+	pos, listStart := 0, 0
+	for _, t := range tokens {
+		buf[pos] = t.item(v, scheme)
+		pos++
+		if t.closes {
+			starts = append(starts, listStart)
+			pos++
+			listStart = pos
+		}
 	}
 
-	ret := stack.Pop().normalize()
-	for !stack.IsEmpty() {
-		ret = append(stack.Pop().normalize(), ret)
+	var ret ListItem
+	end := pos
+	for i := len(starts) - 1; i >= 0; i-- {
+		list := ListItem(buf[starts[i] : end-1]).normalize()
+		if i < len(starts)-1 {
+			// the slot after the list, or a removed null item, holds the sub list
+			list = append(list, ret)
+		}
+		ret = list[:len(list):len(list)]
+		end = starts[i]
 	}
 	return ret
 }
 
+const (
+	tokenZero = iota
+	tokenDigits
+	tokenQualifier
+	tokenShorthand
+)
+
+// token is an item of a version string, v[start:end].
+type token struct {
+	start, end int
+	kind       int
+	// closes is true if the item is the last one of its list.
+	closes bool
+}
+
+func tokenKind(isDigit bool) int {
+	if isDigit {
+		return tokenDigits
+	}
+	return tokenQualifier
+}
+
+func (t token) item(v string, scheme *Scheme) Item {
+	switch t.kind {
+	case tokenDigits:
+		return newIntItem(v[t.start:t.end])
+	case tokenQualifier:
+		return scheme.stringItem(v[t.start:t.end], false)
+	case tokenShorthand:
+		return scheme.stringItem(v[t.start:t.end], true)
+	}
+	return IntItem(0)
+}
+
+// toLowerASCII lowercases the ASCII letters of s, and keeps any other bytes.
+func toLowerASCII(s string) string {
+	for i := 0; i < len(s); i++ {
+		if 'A' <= s[i] && s[i] <= 'Z' {
+			return string(appendLowerASCII(make([]byte, 0, len(s)), s))
+		}
+	}
+	return s
+}
+
+func appendLowerASCII(b []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if 'A' <= c && c <= 'Z' {
+			c += 'a' - 'A'
+		}
+		b = append(b, c)
+	}
+	return b
+}
+
 func compareInt(a, b int) int {
 	if a == b {
 		return 0
@@ -1,6 +1,7 @@
 package version_test
 
 import (
+	"errors"
 	"testing"
 
 	version "github.com/masahiro331/go-mvn-version"
@@ -477,3 +478,214 @@ func TestVersionsNumber(t *testing.T) {
 		}
 	}
 }
+
+func TestNewVersionStrict(t *testing.T) {
+	testCases := []struct {
+		v       string
+		wantErr error
+		wantPos int
+	}{
+		{v: "1.0.0"},
+		{v: "1.0.0-SNAPSHOT"},
+		{v: "2.3.5+build.1"},
+		{v: "1087.1089.v2f1b_9a_b_040e4"},
+		{v: "-----1"},
+		{v: "", wantErr: version.ErrEmptyVersion, wantPos: 0},
+		{v: " 1.0", wantErr: version.ErrInvalidCharacter, wantPos: 0},
+		{v: "1.0 ", wantErr: version.ErrInvalidCharacter, wantPos: 3},
+		{v: "1.0\n", wantErr: version.ErrInvalidCharacter, wantPos: 3},
+		{v: "1.0/2", wantErr: version.ErrInvalidCharacter, wantPos: 3},
+		{v: "1.0:2", wantErr: version.ErrInvalidCharacter, wantPos: 3},
+		{v: "1.0-\x00", wantErr: version.ErrInvalidCharacter, wantPos: 4},
+		{v: "1.0-βeta", wantErr: version.ErrInvalidCharacter, wantPos: 4},
+		{v: "${project.version}", wantErr: version.ErrInvalidCharacter, wantPos: 0},
+	}
+	for _, testCase := range testCases {
+		_, err := version.NewVersion(testCase.v, version.WithStrict())
+		if testCase.wantErr == nil {
+			if err != nil {
+				t.Errorf("%q: unexpected error: %s", testCase.v, err)
+			}
+			continue
+		}
+		if !errors.Is(err, testCase.wantErr) {
+			t.Errorf("%q: expected %v, got %v", testCase.v, testCase.wantErr, err)
+			continue
+		}
+		var perr *version.ParseError
+		if !errors.As(err, &perr) {
+			t.Errorf("%q: expected *ParseError, got %T", testCase.v, err)
+			continue
+		}
+		if perr.Pos != testCase.wantPos {
+			t.Errorf("%q: expected position %d, got %d", testCase.v, testCase.wantPos, perr.Pos)
+		}
+
+		// the lenient parser keeps accepting everything
+		if _, err := version.NewVersion(testCase.v); err != nil {
+			t.Errorf("%q: unexpected lenient error: %s", testCase.v, err)
+		}
+	}
+}
+
+func TestVersionsHugeNumber(t *testing.T) {
+	versionsNumber := []string{
+		"1.2147483647",
+		"1.2147483648",
+		"1.20231010123456789",
+		"1.20231010123456790",
+		"1.9223372036854775806",
+		"1.9223372036854775807",
+		"1.9223372036854775808",
+		"1.09223372036854775809",
+		"1.99999999999999999998",
+		"1.99999999999999999999",
+		"1.100000000000000000000",
+		"1.100000000000000000000.1",
+		"2",
+	}
+	for i := 1; i < len(versionsNumber); i++ {
+		low, err := version.NewVersion(versionsNumber[i-1])
+		if err != nil {
+			t.Errorf("parse error")
+		}
+		for j := i; j < len(versionsNumber); j++ {
+			high, err := version.NewVersion(versionsNumber[j])
+			if err != nil {
+				t.Errorf("parse error")
+			}
+			if !low.LessThan(high) {
+				t.Errorf("expected: %s < %s \n", low, high)
+			}
+			if !high.GreaterThan(low) {
+				t.Errorf("expected: %s > %s \n", high, low)
+			}
+		}
+	}
+
+	equals := [][2]string{
+		{"1.007", "1.7"},
+		{"1.000000000000000000000000", "1"},
+		{"1.0000000000000000000000001", "1.1"},
+		{"99999999999999999999", "0099999999999999999999"},
+		{"1.20231010123456789-1", "1.020231010123456789-1"},
+	}
+	for _, tt := range equals {
+		v1, _ := version.NewVersion(tt[0])
+		v2, _ := version.NewVersion(tt[1])
+		if !v1.Equal(v2) {
+			t.Errorf("expected: %s == %s", v1, v2)
+		}
+	}
+}
+
+func TestCanonical(t *testing.T) {
+	testCases := []struct {
+		v      string
+		expect string
+	}{
+		{v: "1", expect: "1"},
+		{v: "1.0.0", expect: "1"},
+		{v: "1.0.0.RELEASE", expect: "1"},
+		{v: "1-ga", expect: "1"},
+		{v: "1.0.0.FINAL", expect: "1"},
+		{v: "1.2.3-SNAPSHOT", expect: "1.2.3-snapshot"},
+		{v: "1.2.3-cr", expect: "1.2.3-rc"},
+		{v: "1.0-alpha-1", expect: "1-alpha-1"},
+		{v: "1-a1", expect: "1-alpha-1"},
+		{v: "1.0RC1", expect: "1-rc-1"},
+		{v: "1..1", expect: "1.0.1"},
+		{v: "1-1.ga", expect: "1-1"},
+		{v: "1.ga.1", expect: "1..1"},
+		{v: "2.0.a", expect: "2.0.a"},
+		{v: "0.3.0M2", expect: "0.3-milestone-2"},
+		{v: "1.007", expect: "1.7"},
+		{v: "1.00099999999999999999999", expect: "1.99999999999999999999"},
+	}
+	for _, testCase := range testCases {
+		v, err := version.NewVersion(testCase.v)
+		if err != nil {
+			t.Errorf("parse error")
+		}
+		if actual := v.Canonical(); actual != testCase.expect {
+			t.Errorf("%s: actual: %s, expect: %s", testCase.v, actual, testCase.expect)
+		}
+	}
+}
+
+func TestNewVersionAllocs(t *testing.T) {
+	testCases := []struct {
+		v      string
+		allocs float64
+	}{
+		{v: "1.2.3", allocs: 1},
+		{v: "2.0.0.RELEASE", allocs: 1},
+		{v: "1.2.3-SNAPSHOT", allocs: 2},
+		{v: "1.0-alpha-1", allocs: 3},
+		{v: "5.3.20-20220516.081356-12", allocs: 5},
+	}
+	for _, testCase := range testCases {
+		allocs := testing.AllocsPerRun(100, func() {
+			_, _ = version.NewVersion(testCase.v)
+		})
+		if allocs > testCase.allocs {
+			t.Errorf("%s: actual: %v allocs, expect: %v allocs", testCase.v, allocs, testCase.allocs)
+		}
+	}
+}
+
+func TestCompareAllocs(t *testing.T) {
+	var vs []version.Version
+	for _, v := range benchmarkVersions {
+		ver, err := version.NewVersion(v)
+		if err != nil {
+			t.Fatal(err)
+		}
+		vs = append(vs, ver)
+	}
+	allocs := testing.AllocsPerRun(100, func() {
+		for _, v1 := range vs {
+			for _, v2 := range vs {
+				v1.Compare(v2)
+			}
+		}
+	})
+	if allocs != 0 {
+		t.Errorf("actual: %v allocs, expect: 0 allocs", allocs)
+	}
+}
+
+var benchmarkVersions = []string{
+	"1.2.3", "1.2.3-SNAPSHOT", "2.0.0.RELEASE", "1.0-alpha-1", "5.3.20-20220516.081356-12", "Hoxton.SR3",
+}
+
+func BenchmarkNewVersion(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, v := range benchmarkVersions {
+			if _, err := version.NewVersion(v); err != nil {
+				b.Fatal(err)
+			}
+		}
+	}
+}
+
+func BenchmarkCompare(b *testing.B) {
+	var vs []version.Version
+	for _, v := range benchmarkVersions {
+		ver, err := version.NewVersion(v)
+		if err != nil {
+			b.Fatal(err)
+		}
+		vs = append(vs, ver)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for _, v1 := range vs {
+			for _, v2 := range vs {
+				v1.Compare(v2)
+			}
+		}
+	}
+}
@@ -0,0 +1,65 @@
+package version
+
+import (
+	"slices"
+	"strings"
+)
+
+// Compare returns a.Compare(b), so it can be used with slices.SortFunc.
+func Compare(a, b Version) int {
+	return a.Compare(b)
+}
+
+// CompareStable is Compare, but orders equal versions by their original strings.
+// e.g. "1.0" < "1.0.0"
+func CompareStable(a, b Version) int {
+	if result := a.Compare(b); result != 0 {
+		return result
+	}
+	return strings.Compare(a.Value, b.Value)
+}
+
+// Versions is a list of versions which implements sort.Interface.
+// Equal versions are ordered by CompareStable, so sorting is deterministic.
+type Versions []Version
+
+func (vs Versions) Len() int {
+	return len(vs)
+}
+
+func (vs Versions) Less(i, j int) bool {
+	return CompareStable(vs[i], vs[j]) < 0
+}
+
+func (vs Versions) Swap(i, j int) {
+	vs[i], vs[j] = vs[j], vs[i]
+}
+
+// Max returns the greatest version, or false if vs is empty.
+// Of equal versions, the last one in CompareStable order is returned.
+func (vs Versions) Max() (Version, bool) {
+	if len(vs) == 0 {
+		return Version{}, false
+	}
+	return slices.MaxFunc(vs, CompareStable), true
+}
+
+// Min returns the smallest version, or false if vs is empty.
+// Of equal versions, the first one in CompareStable order is returned.
+func (vs Versions) Min() (Version, bool) {
+	if len(vs) == 0 {
+		return Version{}, false
+	}
+	return slices.MinFunc(vs, CompareStable), true
+}
+
+// Dedupe returns the versions sorted with only the first of equal versions in CompareStable order.
+// e.g. "1.0.0", "1", "1.0" => "1"
+// vs is not modified.
+func (vs Versions) Dedupe() Versions {
+	sorted := slices.Clone(vs)
+	slices.SortFunc(sorted, CompareStable)
+	return slices.CompactFunc(sorted, func(a, b Version) bool {
+		return a.Equal(b)
+	})
+}
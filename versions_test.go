@@ -0,0 +1,80 @@
+package version_test
+
+import (
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	version "github.com/masahiro331/go-mvn-version"
+)
+
+func newVersions(t *testing.T, ss ...string) version.Versions {
+	t.Helper()
+	var vs version.Versions
+	for _, s := range ss {
+		v, err := version.NewVersion(s)
+		require.NoError(t, err)
+		vs = append(vs, v)
+	}
+	return vs
+}
+
+func versionStrings(vs []version.Version) []string {
+	var ss []string
+	for _, v := range vs {
+		ss = append(ss, v.String())
+	}
+	return ss
+}
+
+func TestVersions_Sort(t *testing.T) {
+	vs := newVersions(t, "1.0.0", "2.0", "1.0-SNAPSHOT", "1.0", "1", "1.0-alpha-1", "1-ga", "0.9")
+	want := []string{"0.9", "1.0-alpha-1", "1.0-SNAPSHOT", "1", "1-ga", "1.0", "1.0.0", "2.0"}
+
+	sort.Sort(vs)
+	assert.Equal(t, want, versionStrings(vs))
+
+	vs = newVersions(t, "1.0.0", "2.0", "1.0-SNAPSHOT", "1.0", "1", "1.0-alpha-1", "1-ga", "0.9")
+	slices.SortFunc(vs, version.CompareStable)
+	assert.Equal(t, want, versionStrings(vs))
+
+	vs = newVersions(t, "2.0", "1.0.0", "1.0", "0.9")
+	slices.SortStableFunc(vs, version.Compare)
+	assert.Equal(t, []string{"0.9", "1.0.0", "1.0", "2.0"}, versionStrings(vs))
+}
+
+func TestCompareStable(t *testing.T) {
+	vs := newVersions(t, "1.0", "1.0.0", "1.1")
+	assert.Equal(t, 0, version.Compare(vs[0], vs[1]))
+	assert.Equal(t, -1, version.CompareStable(vs[0], vs[1]))
+	assert.Equal(t, 1, version.CompareStable(vs[1], vs[0]))
+	assert.Equal(t, -1, version.CompareStable(vs[1], vs[2]))
+	assert.Equal(t, 0, version.CompareStable(vs[2], vs[2]))
+}
+
+func TestVersions_MaxMin(t *testing.T) {
+	vs := newVersions(t, "1.0", "2.0.0", "1.0-rc1", "2", "2.0")
+
+	max, ok := vs.Max()
+	require.True(t, ok)
+	assert.Equal(t, "2.0.0", max.String())
+
+	min, ok := vs.Min()
+	require.True(t, ok)
+	assert.Equal(t, "1.0-rc1", min.String())
+
+	_, ok = version.Versions{}.Max()
+	assert.False(t, ok)
+	_, ok = version.Versions{}.Min()
+	assert.False(t, ok)
+}
+
+func TestVersions_Dedupe(t *testing.T) {
+	vs := newVersions(t, "1.0.0", "2.0", "1", "1.0", "1-ga", "2.0.RELEASE", "1.1")
+	assert.Equal(t, []string{"1", "1.1", "2.0"}, versionStrings(vs.Dedupe()))
+	assert.Equal(t, "1.0.0", vs[0].String(), "not modified")
+	assert.Empty(t, version.Versions{}.Dedupe())
+}